@@ -0,0 +1,22 @@
+package domain
+
+import "context"
+
+type tenantCtxKey struct{}
+
+// WithTenant stores the tenant of the query being
+// executed in a child context.Context created from
+// the given context.Context.
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantCtxKey{}, tenant)
+}
+
+// GetTenant extracts the tenant of the query being executed
+// from the given context.Context. If none is present, then
+// an empty string is returned.
+func GetTenant(ctx context.Context) string {
+	if t, ok := ctx.Value(tenantCtxKey{}).(string); ok {
+		return t
+	}
+	return ""
+}
@@ -1,5 +1,12 @@
 package domain
 
+import (
+	"strconv"
+
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+	"github.com/pkg/errors"
+)
+
 // Function is the interface implemented by types that
 // provide encoding, filters and special behaviour through
 // the apply operator.
@@ -54,6 +61,80 @@ func (nm NoMultiplex) Map(fn func(target interface{}) interface{}) Function {
 	return NoMultiplex{Value: fn(nm.Value)}
 }
 
+// Cross is a Function that multiplex a list parameter
+// making the cartesian product with the other list
+// parameters, instead of pairing their items by index.
+type Cross struct {
+	Value interface{}
+}
+
+// Argument fetches a Cross argument by name
+func (c Cross) Argument(name string) Arg {
+	return Arg{}
+}
+
+// SetArgument immutably updates the value of an argument by name
+func (c Cross) SetArgument(name string, value interface{}) Function {
+	return c
+}
+
+// Target return the value upon which Cross will be applied.
+func (c Cross) Target() interface{} {
+	return c.Value
+}
+
+// Arguments return the arguments provided to Cross function
+func (c Cross) Arguments() []Arg {
+	return nil
+}
+
+// Map apply the given function to the Target value
+// preserving the Cross as a wrapper.
+func (c Cross) Map(fn func(target interface{}) interface{}) Function {
+	return Cross{Value: fn(c.Value)}
+}
+
+// Batch is a Function that splits a list parameter into
+// groups of the size argument, making one request per group
+// instead of one request per item.
+type Batch struct {
+	Value interface{}
+	Args  []Arg
+}
+
+const BatchArgSize = "size"
+
+// NewBatch constructs a Batch function.
+func NewBatch(target, size interface{}) Batch {
+	return Batch{Value: target, Args: []Arg{{Name: BatchArgSize, Value: size}}}
+}
+
+// Target return the value upon which Batch will be applied.
+func (b Batch) Target() interface{} {
+	return b.Value
+}
+
+// Arguments return the arguments provided to Batch function
+func (b Batch) Arguments() []Arg {
+	return b.Args
+}
+
+// Argument fetches a Batch argument by name
+func (b Batch) Argument(name string) Arg {
+	return findArgument(b.Args, name)
+}
+
+// SetArgument immutably updates the value of an argument by name
+func (b Batch) SetArgument(name string, value interface{}) Function {
+	return Batch{Value: b.Value, Args: setArgument(b.Args, name, value)}
+}
+
+// Map apply the given function to the Target value
+// preserving the Batch as a wrapper.
+func (b Batch) Map(fn func(target interface{}) interface{}) Function {
+	return Batch{Value: fn(b.Value), Args: b.Args}
+}
+
 // JSON is a Function that encode the target value as json.
 type JSON struct {
 	Value interface{}
@@ -353,3 +434,719 @@ func (f AsQuery) Arguments() []Arg {
 func (f AsQuery) Map(fn func(target interface{}) interface{}) Function {
 	return AsQuery{Value: fn(f.Value)}
 }
+
+// Rename is a Function that returns a field selected
+// by the `only` clause under the given Alias.
+type Rename struct {
+	Value interface{}
+	Alias string
+}
+
+// Argument fetches a Rename argument by name
+func (r Rename) Argument(name string) Arg {
+	return Arg{}
+}
+
+// SetArgument immutably updates the value of an argument by name
+func (r Rename) SetArgument(name string, value interface{}) Function {
+	return r
+}
+
+// Target return the value upon which Rename will be applied.
+func (r Rename) Target() interface{} {
+	return r.Value
+}
+
+// Arguments return the arguments provided to Rename function
+func (r Rename) Arguments() []Arg {
+	return nil
+}
+
+// Map apply the given function to the Target value
+// preserving the Rename as a wrapper.
+func (r Rename) Map(fn func(target interface{}) interface{}) Function {
+	return Rename{Value: fn(r.Value), Alias: r.Alias}
+}
+
+// SortBy is a Function that sorts a list of objects
+// on the statement result by the field defined on the path argument.
+type SortBy struct {
+	Value interface{}
+	Args  []Arg
+}
+
+// Arguments names and values accepted by SortBy.
+const (
+	SortByArgPath  = "path"
+	SortByArgOrder = "order"
+
+	SortByOrderAsc  = "asc"
+	SortByOrderDesc = "desc"
+)
+
+// NewSortBy constructs a SortBy function.
+func NewSortBy(target, path, order interface{}) SortBy {
+	return SortBy{
+		Value: target,
+		Args: []Arg{
+			{Name: SortByArgPath, Value: path},
+			{Name: SortByArgOrder, Value: order},
+		},
+	}
+}
+
+// Target return the value upon which SortBy will be applied.
+func (s SortBy) Target() interface{} {
+	return s.Value
+}
+
+// Arguments return the arguments provided to SortBy function
+func (s SortBy) Arguments() []Arg {
+	return s.Args
+}
+
+// Argument fetches a SortBy argument by name
+func (s SortBy) Argument(name string) Arg {
+	return findArgument(s.Args, name)
+}
+
+// SetArgument immutably updates the value of an argument by name
+func (s SortBy) SetArgument(name string, value interface{}) Function {
+	return SortBy{Value: s.Value, Args: setArgument(s.Args, name, value)}
+}
+
+// Map apply the given function to the Target value
+// preserving the SortBy as a wrapper.
+func (s SortBy) Map(fn func(target interface{}) interface{}) Function {
+	return SortBy{Value: fn(s.Value), Args: s.Args}
+}
+
+// Take is a Function that keeps only the first
+// elements of a list on the statement result.
+type Take struct {
+	Value interface{}
+	Args  []Arg
+}
+
+const TakeArgCount = "count"
+
+// NewTake constructs a Take function.
+func NewTake(target, count interface{}) Take {
+	return Take{Value: target, Args: []Arg{{Name: TakeArgCount, Value: count}}}
+}
+
+// Target return the value upon which Take will be applied.
+func (t Take) Target() interface{} {
+	return t.Value
+}
+
+// Arguments return the arguments provided to Take function
+func (t Take) Arguments() []Arg {
+	return t.Args
+}
+
+// Argument fetches a Take argument by name
+func (t Take) Argument(name string) Arg {
+	return findArgument(t.Args, name)
+}
+
+// SetArgument immutably updates the value of an argument by name
+func (t Take) SetArgument(name string, value interface{}) Function {
+	return Take{Value: t.Value, Args: setArgument(t.Args, name, value)}
+}
+
+// Map apply the given function to the Target value
+// preserving the Take as a wrapper.
+func (t Take) Map(fn func(target interface{}) interface{}) Function {
+	return Take{Value: fn(t.Value), Args: t.Args}
+}
+
+// Skip is a Function that drops the first
+// elements of a list on the statement result.
+type Skip struct {
+	Value interface{}
+	Args  []Arg
+}
+
+const SkipArgCount = "count"
+
+// NewSkip constructs a Skip function.
+func NewSkip(target, count interface{}) Skip {
+	return Skip{Value: target, Args: []Arg{{Name: SkipArgCount, Value: count}}}
+}
+
+// Target return the value upon which Skip will be applied.
+func (s Skip) Target() interface{} {
+	return s.Value
+}
+
+// Arguments return the arguments provided to Skip function
+func (s Skip) Arguments() []Arg {
+	return s.Args
+}
+
+// Argument fetches a Skip argument by name
+func (s Skip) Argument(name string) Arg {
+	return findArgument(s.Args, name)
+}
+
+// SetArgument immutably updates the value of an argument by name
+func (s Skip) SetArgument(name string, value interface{}) Function {
+	return Skip{Value: s.Value, Args: setArgument(s.Args, name, value)}
+}
+
+// Map apply the given function to the Target value
+// preserving the Skip as a wrapper.
+func (s Skip) Map(fn func(target interface{}) interface{}) Function {
+	return Skip{Value: fn(s.Value), Args: s.Args}
+}
+
+// Distinct is a Function that removes duplicated elements
+// of a list on the statement result. If a path argument is
+// given, the elements are compared by the field on it.
+type Distinct struct {
+	Value interface{}
+	Args  []Arg
+}
+
+const DistinctArgPath = "path"
+
+// NewDistinct constructs a Distinct function.
+func NewDistinct(target, path interface{}) Distinct {
+	return Distinct{Value: target, Args: []Arg{{Name: DistinctArgPath, Value: path}}}
+}
+
+// Target return the value upon which Distinct will be applied.
+func (d Distinct) Target() interface{} {
+	return d.Value
+}
+
+// Arguments return the arguments provided to Distinct function
+func (d Distinct) Arguments() []Arg {
+	return d.Args
+}
+
+// Argument fetches a Distinct argument by name
+func (d Distinct) Argument(name string) Arg {
+	return findArgument(d.Args, name)
+}
+
+// SetArgument immutably updates the value of an argument by name
+func (d Distinct) SetArgument(name string, value interface{}) Function {
+	return Distinct{Value: d.Value, Args: setArgument(d.Args, name, value)}
+}
+
+// Map apply the given function to the Target value
+// preserving the Distinct as a wrapper.
+func (d Distinct) Map(fn func(target interface{}) interface{}) Function {
+	return Distinct{Value: fn(d.Value), Args: d.Args}
+}
+
+// IndexBy is a Function that transforms a list of objects
+// on the statement result into an object, keyed by the
+// field defined on the path argument.
+type IndexBy struct {
+	Value interface{}
+	Args  []Arg
+}
+
+const IndexByArgPath = "path"
+
+// NewIndexBy constructs an IndexBy function.
+func NewIndexBy(target, path interface{}) IndexBy {
+	return IndexBy{Value: target, Args: []Arg{{Name: IndexByArgPath, Value: path}}}
+}
+
+// Target return the value upon which IndexBy will be applied.
+func (ib IndexBy) Target() interface{} {
+	return ib.Value
+}
+
+// Arguments return the arguments provided to IndexBy function
+func (ib IndexBy) Arguments() []Arg {
+	return ib.Args
+}
+
+// Argument fetches an IndexBy argument by name
+func (ib IndexBy) Argument(name string) Arg {
+	return findArgument(ib.Args, name)
+}
+
+// SetArgument immutably updates the value of an argument by name
+func (ib IndexBy) SetArgument(name string, value interface{}) Function {
+	return IndexBy{Value: ib.Value, Args: setArgument(ib.Args, name, value)}
+}
+
+// Map apply the given function to the Target value
+// preserving the IndexBy as a wrapper.
+func (ib IndexBy) Map(fn func(target interface{}) interface{}) Function {
+	return IndexBy{Value: fn(ib.Value), Args: ib.Args}
+}
+
+// GroupBy is a Function that transforms a list of objects
+// on the statement result into an object of lists, grouping
+// the elements by the field defined on the path argument.
+type GroupBy struct {
+	Value interface{}
+	Args  []Arg
+}
+
+const GroupByArgPath = "path"
+
+// NewGroupBy constructs a GroupBy function.
+func NewGroupBy(target, path interface{}) GroupBy {
+	return GroupBy{Value: target, Args: []Arg{{Name: GroupByArgPath, Value: path}}}
+}
+
+// Target return the value upon which GroupBy will be applied.
+func (gb GroupBy) Target() interface{} {
+	return gb.Value
+}
+
+// Arguments return the arguments provided to GroupBy function
+func (gb GroupBy) Arguments() []Arg {
+	return gb.Args
+}
+
+// Argument fetches a GroupBy argument by name
+func (gb GroupBy) Argument(name string) Arg {
+	return findArgument(gb.Args, name)
+}
+
+// SetArgument immutably updates the value of an argument by name
+func (gb GroupBy) SetArgument(name string, value interface{}) Function {
+	return GroupBy{Value: gb.Value, Args: setArgument(gb.Args, name, value)}
+}
+
+// Map apply the given function to the Target value
+// preserving the GroupBy as a wrapper.
+func (gb GroupBy) Map(fn func(target interface{}) interface{}) Function {
+	return GroupBy{Value: fn(gb.Value), Args: gb.Args}
+}
+
+// Upper is a Function that encode the target value as an upper case string.
+type Upper struct {
+	Value interface{}
+}
+
+// Argument fetches a Upper argument by name
+func (u Upper) Argument(name string) Arg {
+	return Arg{}
+}
+
+// SetArgument immutably updates the value of an argument by name
+func (u Upper) SetArgument(name string, value interface{}) Function {
+	return u
+}
+
+// Target return the value upon which Upper will be applied.
+func (u Upper) Target() interface{} {
+	return u.Value
+}
+
+// Arguments return the arguments provided to Upper function
+func (u Upper) Arguments() []Arg {
+	return nil
+}
+
+// Map apply the given function to the Target value
+// preserving the Upper as a wrapper.
+func (u Upper) Map(fn func(target interface{}) interface{}) Function {
+	return Upper{Value: fn(u.Value)}
+}
+
+// Lower is a Function that encode the target value as a lower case string.
+type Lower struct {
+	Value interface{}
+}
+
+// Argument fetches a Lower argument by name
+func (l Lower) Argument(name string) Arg {
+	return Arg{}
+}
+
+// SetArgument immutably updates the value of an argument by name
+func (l Lower) SetArgument(name string, value interface{}) Function {
+	return l
+}
+
+// Target return the value upon which Lower will be applied.
+func (l Lower) Target() interface{} {
+	return l.Value
+}
+
+// Arguments return the arguments provided to Lower function
+func (l Lower) Arguments() []Arg {
+	return nil
+}
+
+// Map apply the given function to the Target value
+// preserving the Lower as a wrapper.
+func (l Lower) Map(fn func(target interface{}) interface{}) Function {
+	return Lower{Value: fn(l.Value)}
+}
+
+// Trim is a Function that removes leading and trailing white space
+// from the target value.
+type Trim struct {
+	Value interface{}
+}
+
+// Argument fetches a Trim argument by name
+func (t Trim) Argument(name string) Arg {
+	return Arg{}
+}
+
+// SetArgument immutably updates the value of an argument by name
+func (t Trim) SetArgument(name string, value interface{}) Function {
+	return t
+}
+
+// Target return the value upon which Trim will be applied.
+func (t Trim) Target() interface{} {
+	return t.Value
+}
+
+// Arguments return the arguments provided to Trim function
+func (t Trim) Arguments() []Arg {
+	return nil
+}
+
+// Map apply the given function to the Target value
+// preserving the Trim as a wrapper.
+func (t Trim) Map(fn func(target interface{}) interface{}) Function {
+	return Trim{Value: fn(t.Value)}
+}
+
+// ToInt is a Function that converts the target value to an integer.
+type ToInt struct {
+	Value interface{}
+}
+
+// Argument fetches a ToInt argument by name
+func (ti ToInt) Argument(name string) Arg {
+	return Arg{}
+}
+
+// SetArgument immutably updates the value of an argument by name
+func (ti ToInt) SetArgument(name string, value interface{}) Function {
+	return ti
+}
+
+// Target return the value upon which ToInt will be applied.
+func (ti ToInt) Target() interface{} {
+	return ti.Value
+}
+
+// Arguments return the arguments provided to ToInt function
+func (ti ToInt) Arguments() []Arg {
+	return nil
+}
+
+// Map apply the given function to the Target value
+// preserving the ToInt as a wrapper.
+func (ti ToInt) Map(fn func(target interface{}) interface{}) Function {
+	return ToInt{Value: fn(ti.Value)}
+}
+
+// ToString is a Function that converts the target value to a string.
+type ToString struct {
+	Value interface{}
+}
+
+// Argument fetches a ToString argument by name
+func (ts ToString) Argument(name string) Arg {
+	return Arg{}
+}
+
+// SetArgument immutably updates the value of an argument by name
+func (ts ToString) SetArgument(name string, value interface{}) Function {
+	return ts
+}
+
+// Target return the value upon which ToString will be applied.
+func (ts ToString) Target() interface{} {
+	return ts.Value
+}
+
+// Arguments return the arguments provided to ToString function
+func (ts ToString) Arguments() []Arg {
+	return nil
+}
+
+// Map apply the given function to the Target value
+// preserving the ToString as a wrapper.
+func (ts ToString) Map(fn func(target interface{}) interface{}) Function {
+	return ToString{Value: fn(ts.Value)}
+}
+
+// URLEncode is a Function that escapes the target value so it can
+// be safely placed in an URL.
+type URLEncode struct {
+	Value interface{}
+}
+
+// Argument fetches a URLEncode argument by name
+func (ue URLEncode) Argument(name string) Arg {
+	return Arg{}
+}
+
+// SetArgument immutably updates the value of an argument by name
+func (ue URLEncode) SetArgument(name string, value interface{}) Function {
+	return ue
+}
+
+// Target return the value upon which URLEncode will be applied.
+func (ue URLEncode) Target() interface{} {
+	return ue.Value
+}
+
+// Arguments return the arguments provided to URLEncode function
+func (ue URLEncode) Arguments() []Arg {
+	return nil
+}
+
+// Map apply the given function to the Target value
+// preserving the URLEncode as a wrapper.
+func (ue URLEncode) Map(fn func(target interface{}) interface{}) Function {
+	return URLEncode{Value: fn(ue.Value)}
+}
+
+// SHA256 is a Function that encode the target value as the
+// hexadecimal SHA-256 hash of it.
+type SHA256 struct {
+	Value interface{}
+}
+
+// Argument fetches a SHA256 argument by name
+func (s SHA256) Argument(name string) Arg {
+	return Arg{}
+}
+
+// SetArgument immutably updates the value of an argument by name
+func (s SHA256) SetArgument(name string, value interface{}) Function {
+	return s
+}
+
+// Target return the value upon which SHA256 will be applied.
+func (s SHA256) Target() interface{} {
+	return s.Value
+}
+
+// Arguments return the arguments provided to SHA256 function
+func (s SHA256) Arguments() []Arg {
+	return nil
+}
+
+// Map apply the given function to the Target value
+// preserving the SHA256 as a wrapper.
+func (s SHA256) Map(fn func(target interface{}) interface{}) Function {
+	return SHA256{Value: fn(s.Value)}
+}
+
+// Join is a Function that encode a list target value as a string
+// with its items separated by the separator argument.
+type Join struct {
+	Value interface{}
+	Args  []Arg
+}
+
+const JoinArgSeparator = "separator"
+
+// NewJoin constructs a Join function.
+func NewJoin(target, separator interface{}) Join {
+	return Join{Value: target, Args: []Arg{{Name: JoinArgSeparator, Value: separator}}}
+}
+
+// Target return the value upon which Join will be applied.
+func (j Join) Target() interface{} {
+	return j.Value
+}
+
+// Arguments return the arguments provided to Join function
+func (j Join) Arguments() []Arg {
+	return j.Args
+}
+
+// Argument fetches a Join argument by name
+func (j Join) Argument(name string) Arg {
+	return findArgument(j.Args, name)
+}
+
+// SetArgument immutably updates the value of an argument by name
+func (j Join) SetArgument(name string, value interface{}) Function {
+	return Join{Value: j.Value, Args: setArgument(j.Args, name, value)}
+}
+
+// Map apply the given function to the Target value
+// preserving the Join as a wrapper.
+func (j Join) Map(fn func(target interface{}) interface{}) Function {
+	return Join{Value: fn(j.Value), Args: j.Args}
+}
+
+// Split is a Function that transforms a string target value
+// into a list using the separator argument.
+type Split struct {
+	Value interface{}
+	Args  []Arg
+}
+
+const SplitArgSeparator = "separator"
+
+// NewSplit constructs a Split function.
+func NewSplit(target, separator interface{}) Split {
+	return Split{Value: target, Args: []Arg{{Name: SplitArgSeparator, Value: separator}}}
+}
+
+// Target return the value upon which Split will be applied.
+func (s Split) Target() interface{} {
+	return s.Value
+}
+
+// Arguments return the arguments provided to Split function
+func (s Split) Arguments() []Arg {
+	return s.Args
+}
+
+// Argument fetches a Split argument by name
+func (s Split) Argument(name string) Arg {
+	return findArgument(s.Args, name)
+}
+
+// SetArgument immutably updates the value of an argument by name
+func (s Split) SetArgument(name string, value interface{}) Function {
+	return Split{Value: s.Value, Args: setArgument(s.Args, name, value)}
+}
+
+// Map apply the given function to the Target value
+// preserving the Split as a wrapper.
+func (s Split) Map(fn func(target interface{}) interface{}) Function {
+	return Split{Value: fn(s.Value), Args: s.Args}
+}
+
+// HMACSHA256 is a Function that encode the target value as the hexadecimal
+// HMAC-SHA256 of it, signed with the secret argument.
+type HMACSHA256 struct {
+	Value interface{}
+	Args  []Arg
+}
+
+const HMACSHA256ArgSecret = "secret"
+
+// NewHMACSHA256 constructs a HMACSHA256 function.
+func NewHMACSHA256(target, secret interface{}) HMACSHA256 {
+	return HMACSHA256{Value: target, Args: []Arg{{Name: HMACSHA256ArgSecret, Value: secret}}}
+}
+
+// Target return the value upon which HMACSHA256 will be applied.
+func (h HMACSHA256) Target() interface{} {
+	return h.Value
+}
+
+// Arguments return the arguments provided to HMACSHA256 function
+func (h HMACSHA256) Arguments() []Arg {
+	return h.Args
+}
+
+// Argument fetches a HMACSHA256 argument by name
+func (h HMACSHA256) Argument(name string) Arg {
+	return findArgument(h.Args, name)
+}
+
+// SetArgument immutably updates the value of an argument by name
+func (h HMACSHA256) SetArgument(name string, value interface{}) Function {
+	return HMACSHA256{Value: h.Value, Args: setArgument(h.Args, name, value)}
+}
+
+// Map apply the given function to the Target value
+// preserving the HMACSHA256 as a wrapper.
+func (h HMACSHA256) Map(fn func(target interface{}) interface{}) Function {
+	return HMACSHA256{Value: fn(h.Value), Args: h.Args}
+}
+
+// PluginFunction is a Function provided by a plugin, that can be
+// applied to parameter values as well as to filtered fields.
+// Its arguments are positional, named after their index.
+type PluginFunction struct {
+	Value  interface{}
+	Args   []Arg
+	Plugin restql.FunctionPlugin
+}
+
+// NewPluginFunction constructs a PluginFunction.
+func NewPluginFunction(target interface{}, plugin restql.FunctionPlugin, args []interface{}) PluginFunction {
+	fnArgs := make([]Arg, len(args))
+	for i, a := range args {
+		fnArgs[i] = Arg{Name: strconv.Itoa(i), Value: a}
+	}
+
+	return PluginFunction{Value: target, Args: fnArgs, Plugin: plugin}
+}
+
+// Target return the value upon which PluginFunction will be applied.
+func (pf PluginFunction) Target() interface{} {
+	return pf.Value
+}
+
+// Arguments return the arguments provided to PluginFunction function
+func (pf PluginFunction) Arguments() []Arg {
+	return pf.Args
+}
+
+// Argument fetches a PluginFunction argument by name
+func (pf PluginFunction) Argument(name string) Arg {
+	return findArgument(pf.Args, name)
+}
+
+// SetArgument immutably updates the value of an argument by name
+func (pf PluginFunction) SetArgument(name string, value interface{}) Function {
+	return PluginFunction{Value: pf.Value, Args: setArgument(pf.Args, name, value), Plugin: pf.Plugin}
+}
+
+// Map apply the given function to the Target value
+// preserving the PluginFunction as a wrapper.
+func (pf PluginFunction) Map(fn func(target interface{}) interface{}) Function {
+	return PluginFunction{Value: fn(pf.Value), Args: pf.Args, Plugin: pf.Plugin}
+}
+
+// Apply executes the plugin upon the given value with the
+// current arguments, recovering from any panic it produces.
+func (pf PluginFunction) Apply(log restql.Logger, value interface{}) (result interface{}, err error) {
+	defer func() {
+		if reason := recover(); reason != nil {
+			err = errors.Errorf("function plugin %s produced a panic: %v", pf.Plugin.Name(), reason)
+		}
+	}()
+
+	args := make(restql.FunctionArguments, len(pf.Args))
+	for i, a := range pf.Args {
+		args[i] = a.Value
+	}
+
+	return pf.Plugin.Apply(log, value, args)
+}
+
+func findArgument(args []Arg, name string) Arg {
+	for _, arg := range args {
+		if arg.Name == name {
+			return arg
+		}
+	}
+
+	return Arg{}
+}
+
+func setArgument(args []Arg, name string, value interface{}) []Arg {
+	newArg := Arg{Name: name, Value: value}
+	result := make([]Arg, len(args))
+	copy(result, args)
+
+	for i, arg := range result {
+		if arg.Name == name {
+			result[i] = newArg
+			return result
+		}
+	}
+
+	return append(result, newArg)
+}
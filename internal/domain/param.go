@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Types available to declare a query parameter.
+const (
+	StringParam  string = "string"
+	IntParam     string = "int"
+	FloatParam   string = "float"
+	BooleanParam string = "boolean"
+	ListParam    string = "list"
+	EnumParam    string = "enum"
+)
+
+// Param is the internal representation of a parameter
+// declared on the `use params` clause.
+// Enum holds the allowed values of the enum type and
+// Default the value used when the client does not send it.
+type Param struct {
+	Name     string
+	Type     string
+	Enum     []string
+	Required bool
+	Default  interface{}
+}
+
+// Coerce converts a value sent by the client into the declared
+// type, returning an error describing why it is not acceptable.
+// Values received as query parameters are always strings,
+// hence they are parsed into the declared type.
+func (p Param) Coerce(value interface{}) (interface{}, error) {
+	if p.Type == ListParam {
+		if list, ok := value.([]interface{}); ok {
+			return list, nil
+		}
+		return []interface{}{value}, nil
+	}
+
+	if _, ok := value.([]interface{}); ok {
+		return nil, fmt.Errorf("must be a single %s value", p.Type)
+	}
+
+	switch p.Type {
+	case StringParam:
+		return coerceString(value)
+	case IntParam:
+		return coerceInt(value)
+	case FloatParam:
+		return coerceFloat(value)
+	case BooleanParam:
+		return coerceBoolean(value)
+	case EnumParam:
+		return coerceEnum(value, p.Enum)
+	default:
+		return nil, fmt.Errorf("has unknown type %s", p.Type)
+	}
+}
+
+func coerceString(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case string:
+		return value, nil
+	case int, float64, bool:
+		return fmt.Sprintf("%v", value), nil
+	default:
+		return nil, errors.New("must be a string")
+	}
+}
+
+func coerceInt(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case int:
+		return value, nil
+	case float64:
+		if value == math.Trunc(value) {
+			return int(value), nil
+		}
+	case string:
+		if i, err := strconv.Atoi(value); err == nil {
+			return i, nil
+		}
+	}
+
+	return nil, errors.New("must be an int")
+}
+
+func coerceFloat(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case float64:
+		return value, nil
+	case int:
+		return float64(value), nil
+	case string:
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f, nil
+		}
+	}
+
+	return nil, errors.New("must be a float")
+}
+
+func coerceBoolean(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case bool:
+		return value, nil
+	case string:
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b, nil
+		}
+	}
+
+	return nil, errors.New("must be a boolean")
+}
+
+func coerceEnum(value interface{}, allowed []string) (interface{}, error) {
+	if s, ok := value.(string); ok {
+		for _, a := range allowed {
+			if s == a {
+				return s, nil
+			}
+		}
+	}
+
+	quoted := make([]string, len(allowed))
+	for i, a := range allowed {
+		quoted[i] = strconv.Quote(a)
+	}
+
+	return nil, fmt.Errorf("must be one of %s", strings.Join(quoted, ", "))
+}
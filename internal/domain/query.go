@@ -12,26 +12,62 @@ const (
 // Query is the internal representation of the restQL language.
 type Query struct {
 	Use        Modifiers
+	Params     []Param
+	Include    []Include
 	Statements []Statement
 }
 
+// Include is the internal representation of the `include` clause.
+// The Alias is used as prefix to the resource identifiers
+// of the included statements.
+type Include struct {
+	Namespace string
+	Query     string
+	Revision  int
+	Alias     string
+}
+
 // Modifiers is the internal representation of the `use` clause.
 type Modifiers map[string]interface{}
 
 // Statement is the internal representation of a query statement.
+// CrossMultiplex makes every list parameter multiplexed as a
+// cartesian product, as set by the `use multiplex cross` modifier.
+// Batched marks the statements created from the groups of a list
+// parameter with the batch function, whose responses are merged
+// back into a single result.
 type Statement struct {
-	Method       string
-	Resource     string
-	Alias        string
-	In           []string
-	DependsOn    DependsOn
-	Headers      map[string]interface{}
-	Timeout      interface{}
-	With         Params
-	Only         []interface{}
-	Hidden       bool
-	CacheControl CacheControl
-	IgnoreErrors bool
+	Method         string
+	Resource       string
+	Alias          string
+	In             []string
+	InBy           *AggregationKey
+	DependsOn      DependsOn
+	Headers        map[string]interface{}
+	Timeout        interface{}
+	With           Params
+	Only           []interface{}
+	Exclude        [][]string
+	Hidden         bool
+	CacheControl   CacheControl
+	IgnoreErrors   bool
+	When           *Condition
+	Paginate       *Pagination
+	Retry          *Retry
+	OnError        *OnError
+	CrossMultiplex bool
+	Batched        bool
+}
+
+// AggregationKey is the internal representation of the `by` part
+// of the `in` clause. Target is the path of the key on the items
+// of the `in` target and Origin the path on the statement result items.
+// DropUnmatched removes the target items without a match instead
+// of setting null on them, as set by `unmatched drop`.
+type AggregationKey struct {
+	Target        []string
+	Origin        []string
+	DropUnmatched bool
 }
 
 // Params is the internal representation of the `with` clause.
@@ -51,11 +87,84 @@ type Variable struct {
 	Target string
 }
 
+// RequiredVariable is the internal representation of a variable
+// parameter value marked as required, which must be provided
+// by the client for the query to be executed.
+type RequiredVariable struct {
+	Target string
+}
+
+// DefaultVariable is the internal representation of a variable
+// parameter value with a default, used when the client
+// does not provide it.
+type DefaultVariable struct {
+	Target  string
+	Default interface{}
+}
+
 // Chain is the internal representation of a chain parameter value.
 type Chain []interface{}
 
+// Template is the internal representation of a string value
+// with interpolated expressions. Each part is either a literal
+// string, a Variable or a Chain.
+type Template []interface{}
+
+// Conditions available to be used in the `depends-on` clause.
+const (
+	DependsOnSuccess = "success"
+	DependsOnFailure = "failure"
+)
+
 // DependsOn is the internal representation of the `depends-on` clause.
+// When a Condition is given, Resolved indicates that every target
+// meets it, otherwise that every target was successfully resolved.
 type DependsOn struct {
-	Target   string
-	Resolved bool
+	Targets   []string
+	Condition string
+	Resolved  bool
+}
+
+// Operators available to be used in the `when` clause.
+const (
+	EqualOperator    string = "="
+	NotEqualOperator string = "!="
+)
+
+// Condition is the internal representation of the `when` clause.
+// If Operator is empty only the Left operand is evaluated,
+// checking if it exists and is not false.
+type Condition struct {
+	Left     interface{}
+	Operator string
+	Right    interface{}
+}
+
+// Pagination is the internal representation of the `paginate` clause.
+// Param is the parameter sent on the following requests with the
+// value found on Path in the response body of the previous page.
+// MaxPages is the limit of pages requested.
+type Pagination struct {
+	Param    string
+	Path     []string
+	MaxPages interface{}
+}
+
+// Retry is the internal representation of the `retry` clause.
+// Attempts is the maximum number of retries after the first
+// request and Backoff the delay, in milliseconds, before the
+// first retry, doubled on every following one.
+// Unsafe allows retrying statements with methods other than
+// `from`, which are otherwise never retried.
+type Retry struct {
+	Attempts interface{}
+	Backoff  interface{}
+	Unsafe   bool
+}
+
+// OnError is the internal representation of the `on-error` clause.
+// Default is the value used as the statement result when its
+// execution fails.
+type OnError struct {
+	Default interface{}
 }
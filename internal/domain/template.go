@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Render builds the string value of a Template once all of its
+// parts are resolved, returning false otherwise.
+// When parts are resolved to lists a list of strings is built,
+// one for each item, in order to allow multiplexing.
+func (t Template) Render() (interface{}, bool) {
+	n := -1
+	for _, part := range t {
+		switch part := part.(type) {
+		case nil, Variable, RequiredVariable, DefaultVariable, Chain, Function:
+			return nil, false
+		case []interface{}:
+			if n < 0 || len(part) < n {
+				n = len(part)
+			}
+		}
+	}
+
+	if n < 0 {
+		return t.render(), true
+	}
+
+	result := make([]interface{}, n)
+	for i := 0; i < n; i++ {
+		item := make(Template, len(t))
+		for j, part := range t {
+			if list, ok := part.([]interface{}); ok {
+				item[j] = list[i]
+				continue
+			}
+			item[j] = part
+		}
+
+		rendered, ok := item.Render()
+		if !ok {
+			return nil, false
+		}
+		result[i] = rendered
+	}
+
+	return result, true
+}
+
+func (t Template) render() string {
+	var sb strings.Builder
+	for _, part := range t {
+		switch part := part.(type) {
+		case string:
+			sb.WriteString(part)
+		case map[string]interface{}:
+			b, _ := json.Marshal(part)
+			sb.Write(b)
+		default:
+			sb.WriteString(fmt.Sprintf("%v", part))
+		}
+	}
+
+	return sb.String()
+}
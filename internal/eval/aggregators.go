@@ -11,6 +11,9 @@ import (
 // ApplyAggregators resolves the `in` keyword in the query,
 // taking values from one statement result than setting it
 // on target statement result.
+// By default multiplexed results are set on the target list
+// items by position, unless the statement defines the keys
+// used to match them with the `by` part of the clause.
 func ApplyAggregators(log restql.Logger, query domain.Query, resources domain.Resources) domain.Resources {
 	for _, stmt := range query.Statements {
 		if len(stmt.In) == 0 {
@@ -22,11 +25,19 @@ func ApplyAggregators(log restql.Logger, query domain.Query, resources domain.Re
 
 		originResourceID := domain.NewResourceID(stmt)
 		originResource := resources[originResourceID]
+		if dr, ok := originResource.(restql.DoneResource); ok && dr.Skipped {
+			continue
+		}
 
 		targetResourceID := domain.ResourceID(target)
 		targetResource := resources[targetResourceID]
 
-		err := aggregateOriginOnTarget(path, originResource, targetResource)
+		var err error
+		if stmt.InBy != nil {
+			err = aggregateOriginOnTargetByKey(path, *stmt.InBy, originResource, targetResource)
+		} else {
+			err = aggregateOriginOnTarget(path, originResource, targetResource)
+		}
 		if err != nil {
 			log.Error("an error occurred when aggregating the resources", err)
 			continue
@@ -205,3 +216,120 @@ func cleanOriginResult(origin interface{}) interface{} {
 		return nil
 	}
 }
+
+// aggregateOriginOnTargetByKey sets on each target item the
+// origin item with the same key. Target items without a match
+// have the field set to null or, when the key drops unmatched
+// items, are removed from the list holding them.
+// Failed origin results are not matched, unless replaced
+// by their `on-error` default value.
+func aggregateOriginOnTargetByKey(path []string, key domain.AggregationKey, origin interface{}, target interface{}) error {
+	if len(path) == 0 {
+		return errors.New("in clause with by requires a field on the target to set the matched items")
+	}
+
+	index := make(map[string]interface{})
+	for _, item := range collectOriginItems(origin) {
+		k, found := extractKeyOnPath(item, key.Origin)
+		if _, duplicated := index[k]; !found || duplicated {
+			continue
+		}
+
+		index[k] = item
+	}
+
+	return joinOnTarget(path, key.Target, index, key.DropUnmatched, target)
+}
+
+func joinOnTarget(path []string, key []string, index map[string]interface{}, dropUnmatched bool, target interface{}) error {
+	switch target := target.(type) {
+	case restql.DoneResource:
+		if target.ResponseBody == nil {
+			return nil
+		}
+
+		body, keep := joinOnValue(path, key, index, dropUnmatched, target.ResponseBody.Unmarshal())
+		if keep {
+			target.ResponseBody.SetValue(body)
+		}
+		return nil
+	case restql.DoneResources:
+		for _, t := range target {
+			if err := joinOnTarget(path, key, index, dropUnmatched, t); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return errors.Errorf("unknown target type: %T", target)
+	}
+}
+
+// joinOnValue returns the target with the matched items set,
+// and false when the target itself should be dropped.
+func joinOnValue(path []string, key []string, index map[string]interface{}, dropUnmatched bool, target interface{}) (interface{}, bool) {
+	switch target := target.(type) {
+	case []interface{}:
+		result := make([]interface{}, 0, len(target))
+		for _, t := range target {
+			item, keep := joinOnValue(path, key, index, dropUnmatched, t)
+			if keep {
+				result = append(result, item)
+			}
+		}
+		return result, true
+	case map[string]interface{}:
+		field := path[0]
+		if len(path) > 1 {
+			next, found := target[field]
+			if !found {
+				return target, true
+			}
+
+			target[field], _ = joinOnValue(path[1:], key, index, dropUnmatched, next)
+			return target, true
+		}
+
+		k, found := extractKeyOnPath(target, key)
+		match, matched := index[k]
+		if !found || !matched {
+			if dropUnmatched {
+				return nil, false
+			}
+
+			target[field] = nil
+			return target, true
+		}
+
+		target[field] = match
+		return target, true
+	default:
+		return target, true
+	}
+}
+
+func collectOriginItems(origin interface{}) []interface{} {
+	switch origin := origin.(type) {
+	case restql.DoneResource:
+		if origin.ResponseBody == nil || (!origin.Success && !origin.Fallback) {
+			return nil
+		}
+		return collectOriginItems(origin.ResponseBody.Unmarshal())
+	case restql.DoneResources:
+		var items []interface{}
+		for _, o := range origin {
+			items = append(items, collectOriginItems(o)...)
+		}
+		return items
+	case []interface{}:
+		var items []interface{}
+		for _, o := range origin {
+			items = append(items, collectOriginItems(o)...)
+		}
+		return items
+	case nil:
+		return nil
+	default:
+		return []interface{}{origin}
+	}
+}
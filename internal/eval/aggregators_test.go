@@ -316,6 +316,63 @@ func TestApplyAggregators(t *testing.T) {
 				"sidekick": restql.DoneResource{ResponseBody: &restql.ResponseBody{}},
 			},
 		},
+		{
+			"should aggregate multiplexed resource inside list by key setting null to unmatched items",
+			domain.Query{Statements: []domain.Statement{
+				{Resource: "hero"},
+				{Resource: "sidekick", In: []string{"hero", "items", "sidekick"}, InBy: &domain.AggregationKey{Target: []string{"id"}, Origin: []string{"heroId"}}},
+			}},
+			domain.Resources{
+				"hero": restql.DoneResource{ResponseBody: restql.NewResponseBodyFromValue(
+					test.NoOpLogger,
+					test.Unmarshal(`{ "items": [{ "id": 1 }, { "id": 2 }, { "id": 3 }] }`),
+				)},
+				"sidekick": restql.DoneResources{
+					restql.DoneResource{Success: true, ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "heroId": 3, "name": "robin" }`))},
+					restql.DoneResource{Success: true, ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "heroId": 1, "name": "batgirl" }`))},
+					restql.DoneResource{Status: 500, ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "heroId": 2, "error": "unavailable" }`))},
+				},
+			},
+			domain.Resources{
+				"hero": restql.DoneResource{ResponseBody: restql.NewResponseBodyFromValue(
+					test.NoOpLogger,
+					test.Unmarshal(`{ "items": [
+						{ "id": 1, "sidekick": { "heroId": 1, "name": "batgirl" } },
+						{ "id": 2, "sidekick": null },
+						{ "id": 3, "sidekick": { "heroId": 3, "name": "robin" } }
+					] }`),
+				)},
+				"sidekick": restql.DoneResources{
+					restql.DoneResource{Success: true, ResponseBody: &restql.ResponseBody{}},
+					restql.DoneResource{Success: true, ResponseBody: &restql.ResponseBody{}},
+					restql.DoneResource{Status: 500, ResponseBody: &restql.ResponseBody{}},
+				},
+			},
+		},
+		{
+			"should aggregate list resource inside list by key dropping unmatched items",
+			domain.Query{Statements: []domain.Statement{
+				{Resource: "hero"},
+				{Resource: "sidekick", In: []string{"hero", "sidekick"}, InBy: &domain.AggregationKey{Target: []string{"info", "id"}, Origin: []string{"heroId"}, DropUnmatched: true}},
+			}},
+			domain.Resources{
+				"hero": restql.DoneResource{ResponseBody: restql.NewResponseBodyFromValue(
+					test.NoOpLogger,
+					test.Unmarshal(`[{ "info": { "id": "1" } }, { "info": { "id": "2" } }, { "name": "unknown" }]`),
+				)},
+				"sidekick": restql.DoneResource{Success: true, ResponseBody: restql.NewResponseBodyFromValue(
+					test.NoOpLogger,
+					test.Unmarshal(`[{ "heroId": 2, "name": "robin" }, { "heroId": 4, "name": "batgirl" }]`),
+				)},
+			},
+			domain.Resources{
+				"hero": restql.DoneResource{ResponseBody: restql.NewResponseBodyFromValue(
+					test.NoOpLogger,
+					test.Unmarshal(`[{ "info": { "id": "2" }, "sidekick": { "heroId": 2, "name": "robin" } }]`),
+				)},
+				"sidekick": restql.DoneResource{Success: true, ResponseBody: &restql.ResponseBody{}},
+			},
+		},
 	}
 
 	for _, tt := range tests {
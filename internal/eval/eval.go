@@ -3,6 +3,7 @@ package eval
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
 )
 
@@ -30,6 +31,25 @@ var ErrValidation = errors.New("validation error")
 // the asked query has invalid syntax.
 var ErrParser = errors.New("parsing error")
 
+// syntaxError is returned by Evaluator when the query
+// text can not be parsed, preserving the parser error
+// in order to give details about the syntax violation.
+type syntaxError struct {
+	err error
+}
+
+func (se syntaxError) Error() string {
+	return fmt.Sprintf("%s: invalid query syntax %s", ErrParser, se.err)
+}
+
+func (se syntaxError) Is(target error) bool {
+	return target == ErrParser
+}
+
+func (se syntaxError) Unwrap() error {
+	return se.err
+}
+
 // ErrTimeout is returned by Evaluator when
 // the query execution time exceeds the maximum
 // time defined in configuration.
@@ -57,40 +57,221 @@ func (e Evaluator) AdHocQuery(ctx context.Context, queryTxt string, queryOpts re
 // id and revision with the options and HTTP information
 // send by the client.
 func (e Evaluator) SavedQuery(ctx context.Context, queryOpts restql.QueryOptions, queryInput restql.QueryInput) (domain.Resources, error) {
-	err := validateQueryOptions(queryOpts)
+	queryTxt, err := e.fetchSavedQuery(ctx, queryOpts)
 	if err != nil {
 		return nil, err
 	}
 
-	savedQuery, err := e.queryReader.Get(ctx, queryOpts.Namespace, queryOpts.Id, queryOpts.Revision)
+	return e.evaluateQuery(ctx, queryTxt, queryOpts, queryInput)
+}
+
+// AdHocDryRun builds the requests of an ad-hoc query send by the
+// client with the options and HTTP information, without sending them.
+func (e Evaluator) AdHocDryRun(ctx context.Context, queryTxt string, queryOpts restql.QueryOptions, queryInput restql.QueryInput) (map[string]runner.RenderedStatement, error) {
+	if queryOpts.Tenant == "" {
+		return nil, fmt.Errorf("%w: %s", ErrValidation, errInvalidTenant)
+	}
+
+	return e.dryRunQuery(ctx, queryTxt, queryOpts, queryInput)
+}
+
+// SavedDryRun builds the requests of a saved query identified
+// by namespace, id and revision with the options and HTTP
+// information send by the client, without sending them.
+func (e Evaluator) SavedDryRun(ctx context.Context, queryOpts restql.QueryOptions, queryInput restql.QueryInput) (map[string]runner.RenderedStatement, error) {
+	queryTxt, err := e.fetchSavedQuery(ctx, queryOpts)
 	if err != nil {
 		return nil, err
 	}
 
+	return e.dryRunQuery(ctx, queryTxt, queryOpts, queryInput)
+}
+
+func (e Evaluator) fetchSavedQuery(ctx context.Context, queryOpts restql.QueryOptions) (string, error) {
+	err := validateQueryOptions(queryOpts)
+	if err != nil {
+		return "", err
+	}
+
+	savedQuery, err := e.queryReader.Get(ctx, queryOpts.Namespace, queryOpts.Id, queryOpts.Revision)
+	if err != nil {
+		return "", err
+	}
+
 	log := restql.GetLogger(ctx)
 	log.Debug("Saved query retrieved", "query", savedQuery)
 
-	return e.evaluateQuery(ctx, savedQuery.Text, queryOpts, queryInput)
+	return savedQuery.Text, nil
 }
 
-func (e Evaluator) evaluateQuery(ctx context.Context, queryTxt string, queryOpts restql.QueryOptions, queryInput restql.QueryInput) (domain.Resources, error) {
+// LintQuery parses an ad-hoc query and checks it for semantic
+// problems. When a tenant is given in the options the query
+// resources are also checked against its mappings.
+func (e Evaluator) LintQuery(ctx context.Context, queryTxt string, queryOpts restql.QueryOptions) ([]LintIssue, error) {
+	log := restql.GetLogger(ctx)
+
+	query, err := e.parser.Parse(queryTxt)
+	if err != nil {
+		log.Debug("failed to parse query", "error", err)
+		return nil, syntaxError{err: err}
+	}
+
+	query, err = ResolveIncludes(ctx, e.queryReader, e.parser, query, queryOpts)
+	if err != nil {
+		log.Debug("failed to resolve query includes", "error", err)
+		return nil, err
+	}
+
+	var mappings map[string]restql.Mapping
+	if queryOpts.Tenant != "" {
+		mappings, err = e.mappingsReader.FromTenant(ctx, queryOpts.Tenant)
+		if err != nil {
+			log.Error("failed to fetch mappings", err)
+			return nil, err
+		}
+	}
+
+	return Lint(query, mappings), nil
+}
+
+// ExplainQuery builds the execution plan of an ad-hoc query
+// with the options and HTTP information send by the client,
+// without calling any upstream dependency.
+func (e Evaluator) ExplainQuery(ctx context.Context, queryTxt string, queryOpts restql.QueryOptions, queryInput restql.QueryInput) (runner.Plan, error) {
+	if queryOpts.Tenant == "" {
+		return runner.Plan{}, fmt.Errorf("%w: %s", ErrValidation, errInvalidTenant)
+	}
+
+	query, mappings, err := e.prepareQuery(ctx, queryTxt, queryOpts)
+	if err != nil {
+		return runner.Plan{}, err
+	}
+
+	queryInput, err = ValidateParams(query.Params, queryInput)
+	if err != nil {
+		return runner.Plan{}, err
+	}
+
+	queryContext := restql.QueryContext{
+		Mappings: mappings,
+		Options:  queryOpts,
+		Input:    queryInput,
+	}
+
+	query = ResolveVariables(query, queryContext.Input)
+
+	plan, err := e.runner.Explain(query, queryContext)
+	if err != nil {
+		return runner.Plan{}, mapRunnerError(err)
+	}
+
+	return plan, nil
+}
+
+func (e Evaluator) dryRunQuery(ctx context.Context, queryTxt string, queryOpts restql.QueryOptions, queryInput restql.QueryInput) (map[string]runner.RenderedStatement, error) {
+	log := restql.GetLogger(ctx)
+
+	query, mappings, err := e.prepareQuery(ctx, queryTxt, queryOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	queryInput, err = ValidateParams(query.Params, queryInput)
+	if err != nil {
+		log.Debug("query input does not comply with the declared params", "error", err)
+		return nil, err
+	}
+
+	err = ValidateRequiredVariables(query, queryInput)
+	if err != nil {
+		log.Debug("query is missing required variables", "error", err)
+		return nil, err
+	}
+
+	queryContext := restql.QueryContext{
+		Mappings: mappings,
+		Options:  queryOpts,
+		Input:    queryInput,
+	}
+
+	query = ResolveVariables(query, queryContext.Input)
+
+	statements, err := e.runner.DryRun(query, queryContext)
+	if err != nil {
+		return nil, mapRunnerError(err)
+	}
+
+	return statements, nil
+}
+
+// mapRunnerError translates the errors returned by the Runner
+// into the ones of the evaluation, keeping the original message.
+func mapRunnerError(err error) error {
+	switch {
+	case errors.Is(err, runner.ErrQueryTimedOut):
+		return fmt.Errorf("%w: %s", ErrTimeout, err)
+	case errors.Is(err, runner.ErrInvalidChainedParameter),
+		errors.Is(err, runner.ErrInvalidDependsOnTarget),
+		errors.Is(err, runner.ErrInvalidDependsOnCondition),
+		errors.Is(err, runner.ErrUnresolvableDependencies):
+		return fmt.Errorf("%w: %s", ErrParser, err)
+	case errors.Is(err, runner.ErrMaxFanOutExceeded):
+		return fmt.Errorf("%w: %s", ErrValidation, err)
+	default:
+		return err
+	}
+}
+
+// prepareQuery parses the query text, resolving its includes,
+// and fetches the tenant mappings, ensuring every statement
+// references a mapped resource.
+func (e Evaluator) prepareQuery(ctx context.Context, queryTxt string, queryOpts restql.QueryOptions) (domain.Query, map[string]restql.Mapping, error) {
 	log := restql.GetLogger(ctx)
 
 	query, err := e.parser.Parse(queryTxt)
 	if err != nil {
 		log.Debug("failed to parse query", "error", err)
-		return nil, fmt.Errorf("%w: invalid query syntax %s", ErrParser, err)
+		return domain.Query{}, nil, syntaxError{err: err}
+	}
+
+	query, err = ResolveIncludes(ctx, e.queryReader, e.parser, query, queryOpts)
+	if err != nil {
+		log.Debug("failed to resolve query includes", "error", err)
+		return domain.Query{}, nil, err
 	}
 
 	mappings, err := e.mappingsReader.FromTenant(ctx, queryOpts.Tenant)
 	if err != nil {
 		log.Error("failed to fetch mappings", err)
-		return nil, err
+		return domain.Query{}, nil, err
 	}
 
 	err = validateQueryResources(query, mappings)
 	if err != nil {
 		log.Error("query reference invalid resource", err, "mappings", fmt.Sprintf("%#v", mappings))
+		return domain.Query{}, nil, err
+	}
+
+	return query, mappings, nil
+}
+
+func (e Evaluator) evaluateQuery(ctx context.Context, queryTxt string, queryOpts restql.QueryOptions, queryInput restql.QueryInput) (domain.Resources, error) {
+	log := restql.GetLogger(ctx)
+
+	query, mappings, err := e.prepareQuery(ctx, queryTxt, queryOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	queryInput, err = ValidateParams(query.Params, queryInput)
+	if err != nil {
+		log.Debug("query input does not comply with the declared params", "error", err)
+		return nil, err
+	}
+
+	err = ValidateRequiredVariables(query, queryInput)
+	if err != nil {
+		log.Debug("query is missing required variables", "error", err)
 		return nil, err
 	}
 
@@ -105,15 +286,8 @@ func (e Evaluator) evaluateQuery(ctx context.Context, queryTxt string, queryOpts
 	query = ResolveVariables(query, queryContext.Input)
 
 	resources, err := e.runner.ExecuteQuery(queryCtx, query, queryContext)
-	switch {
-	case err == runner.ErrQueryTimedOut:
-		return nil, fmt.Errorf("%w: %s", ErrTimeout, err)
-	case errors.Is(err, runner.ErrInvalidChainedParameter):
-		return nil, fmt.Errorf("%w: %s", ErrParser, err)
-	case errors.Is(err, runner.ErrInvalidDependsOnTarget):
-		return nil, fmt.Errorf("%w: %s", ErrParser, err)
-	case err != nil:
-		return nil, err
+	if err != nil {
+		return nil, mapRunnerError(err)
 	}
 
 	resources, err = ApplyFilters(log, query, resources)
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -15,7 +16,8 @@ import (
 const eot = "end-of-tree"
 
 // ApplyFilters returns a version of the already resolved Resources
-// only with the fields defined by the `only` clause.
+// only with the fields defined by the `only` clause, or without
+// the fields defined by the `exclude` clause.
 func ApplyFilters(log restql.Logger, query domain.Query, resources domain.Resources) (domain.Resources, error) {
 	result := make(domain.Resources)
 
@@ -23,27 +25,97 @@ func ApplyFilters(log restql.Logger, query domain.Query, resources domain.Resour
 		resourceID := domain.NewResourceID(stmt)
 		dr := resources[resourceID]
 
-		filtered, err := applyOnlyFilters(stmt.Only, dr)
+		filtered, err := applyOnlyFilters(log, stmt.Only, dr)
 		if err != nil {
 			log.Error("failed to apply filter on statement", err, "statement", fmt.Sprintf("%+#v", stmt), "done-resource", fmt.Sprintf("%+#v", dr))
 			return nil, err
 		}
 
-		result[resourceID] = filtered
+		result[resourceID] = applyExcludeFilters(stmt.Exclude, filtered)
 	}
 
 	return result, nil
 }
 
-func applyOnlyFilters(filters []interface{}, resourceResult interface{}) (interface{}, error) {
+func applyExcludeFilters(paths [][]string, resourceResult interface{}) interface{} {
+	if len(paths) == 0 {
+		return resourceResult
+	}
+
+	switch resourceResult := resourceResult.(type) {
+	case restql.DoneResource:
+		if resourceResult.Skipped {
+			return resourceResult
+		}
+
+		body := resourceResult.ResponseBody.Unmarshal()
+		for _, path := range paths {
+			body = removePath(body, path)
+		}
+		resourceResult.ResponseBody.SetValue(body)
+
+		return resourceResult
+	case restql.DoneResources:
+		list := make(restql.DoneResources, len(resourceResult))
+		for i, r := range resourceResult {
+			list[i] = applyExcludeFilters(paths, r)
+		}
+		return list
+	default:
+		return resourceResult
+	}
+}
+
+// removePath returns a copy of the value without the field on
+// the given path, which is removed from every element of lists.
+func removePath(value interface{}, path []string) interface{} {
+	if len(path) == 0 {
+		return value
+	}
+
+	switch value := value.(type) {
+	case map[string]interface{}:
+		field, found := value[path[0]]
+		if !found {
+			return value
+		}
+
+		node := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			node[k] = v
+		}
+
+		if len(path) == 1 {
+			delete(node, path[0])
+		} else {
+			node[path[0]] = removePath(field, path[1:])
+		}
+
+		return node
+	case []interface{}:
+		node := make([]interface{}, len(value))
+		for i, v := range value {
+			node[i] = removePath(v, path)
+		}
+		return node
+	default:
+		return value
+	}
+}
+
+func applyOnlyFilters(log restql.Logger, filters []interface{}, resourceResult interface{}) (interface{}, error) {
 	if len(filters) == 0 {
 		return resourceResult, nil
 	}
 
 	switch resourceResult := resourceResult.(type) {
 	case restql.DoneResource:
+		if resourceResult.Skipped {
+			return resourceResult, nil
+		}
+
 		body := resourceResult.ResponseBody.Unmarshal()
-		result, err := extractUsingFilters(buildFilterTree(filters), body)
+		result, err := extractUsingFilters(log, buildFilterTree(filters), body)
 		if err != nil {
 			return nil, err
 		}
@@ -53,7 +125,7 @@ func applyOnlyFilters(filters []interface{}, resourceResult interface{}) (interf
 	case restql.DoneResources:
 		list := make(restql.DoneResources, len(resourceResult))
 		for i, r := range resourceResult {
-			list[i], _ = applyOnlyFilters(filters, r)
+			list[i], _ = applyOnlyFilters(log, filters, r)
 		}
 		return list, nil
 	default:
@@ -61,9 +133,22 @@ func applyOnlyFilters(filters []interface{}, resourceResult interface{}) (interf
 	}
 }
 
-func extractUsingFilters(filters map[string]interface{}, resourceResult interface{}) (interface{}, error) {
-	filters, hasSelectAll := removeSelectAllFilter(filters)
+func extractUsingFilters(log restql.Logger, filters map[string]interface{}, resourceResult interface{}) (interface{}, error) {
+	filters, selectAll := removeSelectAllFilter(filters)
+
+	result, err := extractFields(log, filters, selectAll != nil, resourceResult)
+	if err != nil {
+		return nil, err
+	}
+
+	if fn, ok := selectAll.(domain.Function); ok {
+		return applyWholeValueFilter(log, fn, result)
+	}
+
+	return result, nil
+}
 
+func extractFields(log restql.Logger, filters map[string]interface{}, hasSelectAll bool, resourceResult interface{}) (interface{}, error) {
 	switch resourceResult := resourceResult.(type) {
 	case map[string]interface{}:
 		node := makeMapNode(hasSelectAll, resourceResult)
@@ -74,30 +159,19 @@ func extractUsingFilters(filters map[string]interface{}, resourceResult interfac
 				continue
 			}
 
-			if subFilter == eot {
-				node[key] = value
-				continue
-			}
-
-			switch subFilter := subFilter.(type) {
-			case domain.Match:
-				err := applyMatchFilter(subFilter, key, value, node)
-				if err != nil {
-					return nil, err
-				}
-			case domain.FilterByRegex:
-				err := applyFilterByRegex(subFilter, key, value, node)
-				if err != nil {
-					return nil, err
-				}
-			case map[string]interface{}:
-				f, err := extractUsingFilters(subFilter, value)
+			if subFilter, ok := subFilter.(map[string]interface{}); ok {
+				f, err := extractUsingFilters(log, subFilter, value)
 				if err != nil {
 					return nil, err
 				}
 				node[key] = f
+				continue
 			}
 
+			err := applyLeafFilter(log, subFilter, key, value, node)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		return node, nil
@@ -105,7 +179,7 @@ func extractUsingFilters(filters map[string]interface{}, resourceResult interfac
 		node := makeListNode(hasSelectAll, resourceResult)
 
 		for i, r := range resourceResult {
-			f, err := extractUsingFilters(filters, r)
+			f, err := extractFields(log, filters, hasSelectAll, r)
 			if err != nil {
 				return nil, err
 			}
@@ -118,6 +192,83 @@ func extractUsingFilters(filters map[string]interface{}, resourceResult interfac
 	}
 }
 
+// applyLeafFilter sets the value on the node key transformed
+// by the filter functions, applying the innermost one first.
+func applyLeafFilter(log restql.Logger, filter interface{}, key string, value interface{}, node map[string]interface{}) error {
+	if fn, ok := filter.(domain.Function); ok {
+		if inner, ok := fn.Target().(domain.Function); ok {
+			err := applyLeafFilter(log, inner, key, value, node)
+			if err != nil {
+				return err
+			}
+
+			innerValue, found := node[key]
+			if !found {
+				return nil
+			}
+			value = innerValue
+		}
+	}
+
+	switch filter := filter.(type) {
+	case domain.Match:
+		return applyMatchFilter(filter, key, value, node)
+	case domain.FilterByRegex:
+		return applyFilterByRegex(filter, key, value, node)
+	case domain.SortBy:
+		node[key] = applySortBy(filter, value)
+		return nil
+	case domain.Take:
+		node[key] = applyTake(filter, value)
+		return nil
+	case domain.Skip:
+		node[key] = applySkip(filter, value)
+		return nil
+	case domain.Distinct:
+		node[key] = applyDistinct(filter, value)
+		return nil
+	case domain.IndexBy:
+		node[key] = applyIndexBy(filter, value)
+		return nil
+	case domain.GroupBy:
+		node[key] = applyGroupBy(filter, value)
+		return nil
+	case domain.PluginFunction:
+		applyPluginFunction(log, filter, key, value, node)
+		return nil
+	case domain.Rename:
+		delete(node, key)
+		node[filter.Alias] = value
+		return nil
+	default:
+		node[key] = value
+		return nil
+	}
+}
+
+// applyWholeValueFilter transforms the entire value by the
+// filter functions set on the select all path, as done on fields.
+func applyWholeValueFilter(log restql.Logger, fn domain.Function, value interface{}) (interface{}, error) {
+	node := map[string]interface{}{"*": value}
+	err := applyLeafFilter(log, fn, "*", value, node)
+	if err != nil {
+		return nil, err
+	}
+
+	return node["*"], nil
+}
+
+func applyPluginFunction(log restql.Logger, fn domain.PluginFunction, key string, value interface{}, node map[string]interface{}) {
+	result, err := fn.Apply(log, value)
+	if err != nil {
+		log.Warn("failed to apply function plugin on filter", "error", err.Error(), "function", fn.Plugin.Name(), "field", key)
+		delete(node, key)
+		return
+	}
+
+	node[key] = result
+}
+
 func makeMapNode(hasSelectAll bool, resourceResult map[string]interface{}) map[string]interface{} {
 	var node map[string]interface{}
 	if hasSelectAll {
@@ -138,19 +289,19 @@ func makeListNode(hasSelectAll bool, resourceResult []interface{}) []interface{}
 	return node
 }
 
-func removeSelectAllFilter(filters map[string]interface{}) (map[string]interface{}, bool) {
+func removeSelectAllFilter(filters map[string]interface{}) (map[string]interface{}, interface{}) {
 	m := make(map[string]interface{})
-	has := false
+	var selectAll interface{}
 
 	for k, v := range filters {
 		if k != "*" {
 			m[k] = v
 		} else {
-			has = true
+			selectAll = v
 		}
 	}
 
-	return m, has
+	return m, selectAll
 }
 
 func applyFilterByRegex(fn domain.FilterByRegex, key string, value interface{}, node map[string]interface{}) error {
@@ -269,6 +420,256 @@ func applyMatchFilter(filter domain.Match, key string, value interface{}, node m
 	}
 }
 
+func applySortBy(fn domain.SortBy, value interface{}) interface{} {
+	list, ok := value.([]interface{})
+	if !ok {
+		return value
+	}
+
+	rawPath, ok := fn.Argument(domain.SortByArgPath).Value.(string)
+	if !ok {
+		return value
+	}
+	path := strings.Split(rawPath, ".")
+
+	order, _ := fn.Argument(domain.SortByArgOrder).Value.(string)
+	desc := strings.EqualFold(order, domain.SortByOrderDesc)
+
+	result := make([]interface{}, len(list))
+	copy(result, list)
+
+	sort.SliceStable(result, func(i, j int) bool {
+		a, aFound := extractValueOnPath(result[i], path)
+		b, bFound := extractValueOnPath(result[j], path)
+
+		switch {
+		case !aFound || !bFound:
+			return aFound && !bFound
+		case desc:
+			return compareValues(b, a) < 0
+		default:
+			return compareValues(a, b) < 0
+		}
+	})
+
+	return result
+}
+
+func compareValues(a, b interface{}) int {
+	aNumber, aIsNumber := toFloat(a)
+	bNumber, bIsNumber := toFloat(b)
+	if aIsNumber && bIsNumber {
+		switch {
+		case aNumber < bNumber:
+			return -1
+		case aNumber > bNumber:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	aStr, _ := stringify(a)
+	bStr, _ := stringify(b)
+
+	return strings.Compare(aStr, bStr)
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch value := value.(type) {
+	case float64:
+		return value, true
+	case int:
+		return float64(value), true
+	default:
+		return 0, false
+	}
+}
+
+func applyTake(fn domain.Take, value interface{}) interface{} {
+	list, ok := value.([]interface{})
+	if !ok {
+		return value
+	}
+
+	count, ok := parseCount(fn.Argument(domain.TakeArgCount).Value)
+	if !ok {
+		return value
+	}
+
+	if count > len(list) {
+		count = len(list)
+	}
+
+	return list[:count]
+}
+
+func applySkip(fn domain.Skip, value interface{}) interface{} {
+	list, ok := value.([]interface{})
+	if !ok {
+		return value
+	}
+
+	count, ok := parseCount(fn.Argument(domain.SkipArgCount).Value)
+	if !ok {
+		return value
+	}
+
+	if count > len(list) {
+		count = len(list)
+	}
+
+	return list[count:]
+}
+
+func parseCount(value interface{}) (int, bool) {
+	var count int
+
+	switch value := value.(type) {
+	case int:
+		count = value
+	case float64:
+		count = int(value)
+	case string:
+		c, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, false
+		}
+		count = c
+	default:
+		return 0, false
+	}
+
+	if count < 0 {
+		return 0, true
+	}
+
+	return count, true
+}
+
+func applyDistinct(fn domain.Distinct, value interface{}) interface{} {
+	list, ok := value.([]interface{})
+	if !ok {
+		return value
+	}
+
+	var path []string
+	switch rawPath := fn.Argument(domain.DistinctArgPath).Value.(type) {
+	case string:
+		path = strings.Split(rawPath, ".")
+	case nil:
+	default:
+		return value
+	}
+
+	seen := make(map[string]bool)
+	result := make([]interface{}, 0, len(list))
+	for _, v := range list {
+		target, found := extractValueOnPath(v, path)
+		if !found {
+			result = append(result, v)
+			continue
+		}
+
+		key, err := json.Marshal(target)
+		if err != nil {
+			result = append(result, v)
+			continue
+		}
+
+		if seen[string(key)] {
+			continue
+		}
+
+		seen[string(key)] = true
+		result = append(result, v)
+	}
+
+	return result
+}
+
+func applyIndexBy(fn domain.IndexBy, value interface{}) interface{} {
+	list, ok := value.([]interface{})
+	if !ok {
+		return value
+	}
+
+	path, ok := parseKeyPath(fn.Argument(domain.IndexByArgPath).Value)
+	if !ok {
+		return value
+	}
+
+	result := make(map[string]interface{})
+	for _, v := range list {
+		key, found := extractKeyOnPath(v, path)
+		if !found {
+			continue
+		}
+
+		result[key] = v
+	}
+
+	return result
+}
+
+func applyGroupBy(fn domain.GroupBy, value interface{}) interface{} {
+	list, ok := value.([]interface{})
+	if !ok {
+		return value
+	}
+
+	path, ok := parseKeyPath(fn.Argument(domain.GroupByArgPath).Value)
+	if !ok {
+		return value
+	}
+
+	result := make(map[string]interface{})
+	for _, v := range list {
+		key, found := extractKeyOnPath(v, path)
+		if !found {
+			continue
+		}
+
+		group, _ := result[key].([]interface{})
+		result[key] = append(group, v)
+	}
+
+	return result
+}
+
+func parseKeyPath(rawPath interface{}) ([]string, bool) {
+	path, ok := rawPath.(string)
+	if !ok || path == "" {
+		return nil, false
+	}
+
+	return strings.Split(path, "."), true
+}
+
+func extractKeyOnPath(value interface{}, path []string) (string, bool) {
+	target, found := extractValueOnPath(value, path)
+	if !found {
+		return "", false
+	}
+
+	switch target := target.(type) {
+	case string:
+		return target, true
+	case float64:
+		return strconv.FormatFloat(target, 'f', -1, 64), true
+	case int:
+		return strconv.Itoa(target), true
+	case bool:
+		return strconv.FormatBool(target), true
+	default:
+		key, err := json.Marshal(target)
+		if err != nil {
+			return "", false
+		}
+		return string(key), true
+	}
+}
+
 var errUnknownRegexType = errors.New("failed to parse match argument : unknown regex argument type")
 
 func parseRegex(regex interface{}) (*regexp.Regexp, error) {
@@ -306,7 +707,7 @@ func buildPathInTree(path []interface{}, tree map[string]interface{}) {
 		field = f
 		leaf = eot
 	case domain.Function:
-		fields, ok := f.Target().([]string)
+		fields, ok := filterTarget(f)
 		if !ok {
 			return
 		}
@@ -347,7 +748,7 @@ func parsePath(s interface{}) []interface{} {
 		}
 		return result
 	case domain.Function:
-		items, ok := s.Target().([]string)
+		items, ok := filterTarget(s)
 		if !ok {
 			return nil
 		}
@@ -355,9 +756,7 @@ func parsePath(s interface{}) []interface{} {
 		result := make([]interface{}, len(items))
 		for i, item := range items {
 			if i == len(items)-1 {
-				result[i] = s.Map(func(_ interface{}) interface{} {
-					return []string{item}
-				})
+				result[i] = setFilterTarget(s, []string{item})
 			} else {
 				result[i] = item
 			}
@@ -368,6 +767,27 @@ func parsePath(s interface{}) []interface{} {
 	}
 }
 
+func filterTarget(fn domain.Function) ([]string, bool) {
+	switch target := fn.Target().(type) {
+	case []string:
+		return target, true
+	case domain.Function:
+		return filterTarget(target)
+	default:
+		return nil, false
+	}
+}
+
+func setFilterTarget(fn domain.Function, path []string) domain.Function {
+	return fn.Map(func(target interface{}) interface{} {
+		if inner, ok := target.(domain.Function); ok {
+			return setFilterTarget(inner, path)
+		}
+
+		return path
+	})
+}
+
 func stringify(value interface{}) (string, error) {
 	switch value := value.(type) {
 	case string:
@@ -1,6 +1,7 @@
 package eval_test
 
 import (
+	"errors"
 	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
 	"regexp"
 	"testing"
@@ -541,6 +542,295 @@ func TestOnlyFilters(t *testing.T) {
 				},
 			},
 		},
+		{
+			"should rename the given fields",
+			domain.Query{Statements: []domain.Statement{{
+				Resource: "hero",
+				Only: []interface{}{
+					domain.Rename{Value: []string{"name"}, Alias: "heroName"},
+					domain.Rename{Value: []string{"city", "name"}, Alias: "cityName"},
+					domain.Rename{Value: []string{"weapons", "id"}, Alias: "weaponId"},
+					[]string{"age"},
+				},
+			}}},
+			domain.Resources{
+				"hero": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "id": "12345", "name": "batman", "age": 42, "city": {"name": "gotham", "state": "NJ"}, "weapons": [{"id": 1, "name": "belt"}, {"id": 2, "name": "batarang"}] }`)),
+				},
+			},
+			domain.Resources{
+				"hero": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "heroName": "batman", "age": 42, "city": {"cityName": "gotham"}, "weapons": [{"weaponId": 1}, {"weaponId": 2}] }`)),
+				},
+			},
+		},
+		{
+			"should rename fields alongside select all filter",
+			domain.Query{Statements: []domain.Statement{{
+				Resource: "hero",
+				Only: []interface{}{
+					[]string{"*"},
+					domain.Rename{Value: []string{"name"}, Alias: "heroName"},
+				},
+			}}},
+			domain.Resources{
+				"hero": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "id": "12345", "name": "batman", "age": 42 }`)),
+				},
+			},
+			domain.Resources{
+				"hero": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "id": "12345", "heroName": "batman", "age": 42 }`)),
+				},
+			},
+		},
+		{
+			"should rename fields filtered by functions",
+			domain.Query{Statements: []domain.Statement{{
+				Resource: "hero",
+				Only: []interface{}{
+					domain.Rename{Value: domain.Match{Value: []string{"name"}, Args: []domain.Arg{{Name: domain.MatchArgRegex, Value: regexp.MustCompile("^b")}}}, Alias: "heroName"},
+					domain.Rename{Value: domain.Match{Value: []string{"nickname"}, Args: []domain.Arg{{Name: domain.MatchArgRegex, Value: regexp.MustCompile("^b")}}}, Alias: "heroNickname"},
+					domain.Rename{Value: domain.NewFilterByRegex([]string{"weapons"}, "type", "attack"), Alias: "attackWeapons"},
+				},
+			}}},
+			domain.Resources{
+				"hero": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "id": "12345", "name": "batman", "nickname": "dark knight", "weapons": [{"id": 1, "type": "attack"}, {"id": 2, "type": "defense"}] }`)),
+				},
+			},
+			domain.Resources{
+				"hero": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "heroName": "batman", "attackWeapons": [{"id": 1, "type": "attack"}] }`)),
+				},
+			},
+		},
+		{
+			"should sort, skip and take list elements",
+			domain.Query{Statements: []domain.Statement{{
+				Resource: "product",
+				Only: []interface{}{
+					domain.NewTake(domain.NewSkip(domain.NewSortBy([]string{"offers"}, "price", "desc"), 1), "2"),
+					domain.NewSortBy([]string{"sellers"}, "name", domain.SortByOrderAsc),
+				},
+			}}},
+			domain.Resources{
+				"product": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "offers": [{"id": 1, "price": 10}, {"id": 2, "price": 30.5}, {"id": 3}, {"id": 4, "price": 20}, {"id": 5, "price": 15}], "sellers": [{"name": "b"}, {"name": "c"}, {"name": "a"}] }`)),
+				},
+			},
+			domain.Resources{
+				"product": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "offers": [{"id": 4, "price": 20}, {"id": 5, "price": 15}], "sellers": [{"name": "a"}, {"name": "b"}, {"name": "c"}] }`)),
+				},
+			},
+		},
+		{
+			"should remove duplicated list elements",
+			domain.Query{Statements: []domain.Statement{{
+				Resource: "product",
+				Only: []interface{}{
+					domain.NewDistinct([]string{"offers"}, "seller.id"),
+					domain.NewDistinct([]string{"tags"}, nil),
+				},
+			}}},
+			domain.Resources{
+				"product": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "offers": [{"id": 1, "seller": {"id": "a"}}, {"id": 2, "seller": {"id": "b"}}, {"id": 3, "seller": {"id": "a"}}], "tags": ["new", "sale", "new"] }`)),
+				},
+			},
+			domain.Resources{
+				"product": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "offers": [{"id": 1, "seller": {"id": "a"}}, {"id": 2, "seller": {"id": "b"}}], "tags": ["new", "sale"] }`)),
+				},
+			},
+		},
+		{
+			"should not apply list functions if arguments are not resolved",
+			domain.Query{Statements: []domain.Statement{{
+				Resource: "product",
+				Only: []interface{}{
+					domain.NewTake([]string{"offers"}, domain.Variable{Target: "size"}),
+					domain.NewSortBy([]string{"sellers"}, domain.Variable{Target: "sort"}, domain.SortByOrderAsc),
+				},
+			}}},
+			domain.Resources{
+				"product": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "offers": [{"id": 1}, {"id": 2}], "sellers": [{"name": "b"}, {"name": "a"}] }`)),
+				},
+			},
+			domain.Resources{
+				"product": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "offers": [{"id": 1}, {"id": 2}], "sellers": [{"name": "b"}, {"name": "a"}] }`)),
+				},
+			},
+		},
+		{
+			"should apply function plugins to filtered fields",
+			domain.Query{Statements: []domain.Statement{{
+				Resource: "hero",
+				Only: []interface{}{
+					domain.NewPluginFunction([]string{"name"}, suffixFunction("suffix"), []interface{}{"!"}),
+					domain.NewPluginFunction([]string{"age"}, suffixFunction("suffix"), []interface{}{"!"}),
+				},
+			}}},
+			domain.Resources{
+				"hero": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "name": "batman", "age": 42, "city": "gotham" }`)),
+				},
+			},
+			domain.Resources{
+				"hero": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "name": "batman!" }`)),
+				},
+			},
+		},
+		{
+			"should index and group list elements by a field",
+			domain.Query{Statements: []domain.Statement{{
+				Resource: "product",
+				Only: []interface{}{
+					domain.NewIndexBy([]string{"skus"}, "id"),
+					domain.NewGroupBy([]string{"offers"}, "seller.id"),
+				},
+			}}},
+			domain.Resources{
+				"product": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "skus": [{"id": 1, "name": "a"}, {"id": "2", "name": "b"}, {"name": "c"}], "offers": [{"id": 1, "seller": {"id": "x"}}, {"id": 2, "seller": {"id": "y"}}, {"id": 3, "seller": {"id": "x"}}, {"id": 4}] }`)),
+				},
+			},
+			domain.Resources{
+				"product": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "skus": {"1": {"id": 1, "name": "a"}, "2": {"id": "2", "name": "b"}}, "offers": {"x": [{"id": 1, "seller": {"id": "x"}}, {"id": 3, "seller": {"id": "x"}}], "y": [{"id": 2, "seller": {"id": "y"}}]} }`)),
+				},
+			},
+		},
+		{
+			"should index list elements of multiplexed results",
+			domain.Query{Statements: []domain.Statement{{
+				Resource: "product",
+				Only:     []interface{}{domain.NewIndexBy([]string{"skus"}, "id")},
+			}}},
+			domain.Resources{
+				"product": restql.DoneResources{
+					restql.DoneResource{ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "skus": [{"id": "a"}, {"id": "b"}] }`))},
+					restql.DoneResource{ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "skus": [{"id": "c"}] }`))},
+				},
+			},
+			domain.Resources{
+				"product": restql.DoneResources{
+					restql.DoneResource{ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "skus": {"a": {"id": "a"}, "b": {"id": "b"}} }`))},
+					restql.DoneResource{ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "skus": {"c": {"id": "c"}} }`))},
+				},
+			},
+		},
+		{
+			"should sort and take elements of a list response selected entirely",
+			domain.Query{Statements: []domain.Statement{{
+				Resource: "product",
+				Only:     []interface{}{domain.NewTake(domain.NewSortBy([]string{"*"}, "price", domain.SortByOrderAsc), "2")},
+			}}},
+			domain.Resources{
+				"product": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`[{"id": 1, "price": 30}, {"id": 2, "price": 10}, {"id": 3, "price": 20}]`)),
+				},
+			},
+			domain.Resources{
+				"product": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`[{"id": 2, "price": 10}, {"id": 3, "price": 20}]`)),
+				},
+			},
+		},
+		{
+			"should index elements of a list response selected entirely",
+			domain.Query{Statements: []domain.Statement{{
+				Resource: "product",
+				Only:     []interface{}{domain.NewIndexBy([]string{"*"}, "id")},
+			}}},
+			domain.Resources{
+				"product": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`[{"id": "a", "name": "x"}, {"id": "b", "name": "y"}]`)),
+				},
+			},
+			domain.Resources{
+				"product": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{"a": {"id": "a", "name": "x"}, "b": {"id": "b", "name": "y"}}`)),
+				},
+			},
+		},
+		{
+			"should bring every element of a list response",
+			domain.Query{Statements: []domain.Statement{{
+				Resource: "product",
+				Only:     []interface{}{[]string{"*"}},
+			}}},
+			domain.Resources{
+				"product": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`[{"id": 1, "tags": [{"name": "a"}]}, {"id": 2}]`)),
+				},
+			},
+			domain.Resources{
+				"product": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`[{"id": 1, "tags": [{"name": "a"}]}, {"id": 2}]`)),
+				},
+			},
+		},
+		{
+			"should not index or group if path argument is not resolved",
+			domain.Query{Statements: []domain.Statement{{
+				Resource: "product",
+				Only: []interface{}{
+					domain.NewIndexBy([]string{"skus"}, domain.Variable{Target: "key"}),
+					domain.NewGroupBy([]string{"offers"}, domain.Variable{Target: "key"}),
+				},
+			}}},
+			domain.Resources{
+				"product": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "skus": [{"id": 1}], "offers": [{"id": 2}] }`)),
+				},
+			},
+			domain.Resources{
+				"product": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "skus": [{"id": 1}], "offers": [{"id": 2}] }`)),
+				},
+			},
+		},
+		{
+			"should remove excluded fields, including nested and list paths",
+			domain.Query{Statements: []domain.Statement{{
+				Resource: "product",
+				Exclude:  [][]string{{"description"}, {"seller", "audit"}, {"offers", "html"}, {"unknown", "field"}},
+			}}},
+			domain.Resources{
+				"product": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "id": 1, "description": "<p>...</p>", "seller": {"id": "a", "audit": []}, "offers": [{"id": 1, "html": "<p>"}, {"id": 2}] }`)),
+				},
+			},
+			domain.Resources{
+				"product": restql.DoneResource{
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "id": 1, "seller": {"id": "a"}, "offers": [{"id": 1}, {"id": 2}] }`)),
+				},
+			},
+		},
+		{
+			"should remove excluded fields of multiplexed results",
+			domain.Query{Statements: []domain.Statement{{
+				Resource: "product",
+				Exclude:  [][]string{{"description"}},
+			}}},
+			domain.Resources{
+				"product": restql.DoneResources{
+					restql.DoneResource{ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "id": 1, "description": "a" }`))},
+					restql.DoneResource{ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`[{ "id": 2, "description": "b" }]`))},
+				},
+			},
+			domain.Resources{
+				"product": restql.DoneResources{
+					restql.DoneResource{ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{ "id": 1 }`))},
+					restql.DoneResource{ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`[{ "id": 2 }]`))},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -552,3 +842,23 @@ func TestOnlyFilters(t *testing.T) {
 		})
 	}
 }
+
+type suffixFunction string
+
+func (s suffixFunction) Name() string {
+	return string(s)
+}
+
+func (s suffixFunction) Type() restql.PluginType {
+	return restql.FunctionPluginType
+}
+
+func (s suffixFunction) Apply(log restql.Logger, value interface{}, args restql.FunctionArguments) (interface{}, error) {
+	str, ok := value.(string)
+	if !ok {
+		return nil, errors.New("value is not a string")
+	}
+
+	suffix, _ := args.String(0)
+	return str + suffix, nil
+}
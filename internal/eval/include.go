@@ -0,0 +1,220 @@
+package eval
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/internal/parser"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+)
+
+const includeAliasSeparator = ":"
+
+// ResolveIncludes returns a restQL query with the statements of
+// every included saved query spliced into it.
+// The resource identifier of an included statement, as well as
+// the references to it inside the included query, are prefixed
+// by the include alias in order to avoid collisions.
+func ResolveIncludes(ctx context.Context, qr QueryReader, p parser.Parser, query domain.Query, queryOpts restql.QueryOptions) (domain.Query, error) {
+	var visited []string
+	if queryOpts.Id != "" {
+		visited = append(visited, includeKey(queryOpts.Namespace, queryOpts.Id, queryOpts.Revision))
+	}
+
+	return resolveIncludes(ctx, qr, p, query, visited)
+}
+
+func resolveIncludes(ctx context.Context, qr QueryReader, p parser.Parser, query domain.Query, visited []string) (domain.Query, error) {
+	if len(query.Include) == 0 {
+		return query, nil
+	}
+
+	params := query.Params
+	var statements []domain.Statement
+	for _, inc := range query.Include {
+		key := includeKey(inc.Namespace, inc.Query, inc.Revision)
+		path := append(visited[:len(visited):len(visited)], key)
+
+		if containsKey(visited, key) {
+			return domain.Query{}, fmt.Errorf("%w: include cycle detected: %s", ErrParser, strings.Join(path, " -> "))
+		}
+
+		savedQuery, err := qr.Get(ctx, inc.Namespace, inc.Query, inc.Revision)
+		if err != nil {
+			return domain.Query{}, fmt.Errorf("failed to fetch included query %s: %w", key, err)
+		}
+
+		included, err := p.Parse(savedQuery.Text)
+		if err != nil {
+			return domain.Query{}, fmt.Errorf("%w: invalid query syntax on included query %s: %s", ErrParser, key, err)
+		}
+
+		included, err = resolveIncludes(ctx, qr, p, included, path)
+		if err != nil {
+			return domain.Query{}, err
+		}
+
+		params, err = mergeParams(params, included.Params, key)
+		if err != nil {
+			return domain.Query{}, err
+		}
+
+		statements = append(statements, prefixStatements(included.Statements, inc.Alias)...)
+	}
+
+	statements = append(statements, query.Statements...)
+
+	return domain.Query{Use: query.Use, Params: params, Statements: statements}, nil
+}
+
+// mergeParams adds the params declared by an included query to the
+// ones already known, since variables are shared by every statement.
+// The same param can be declared more than once, but only if all
+// declarations are equal.
+func mergeParams(params []domain.Param, included []domain.Param, key string) ([]domain.Param, error) {
+	result := params[:len(params):len(params)]
+	for _, inc := range included {
+		found := false
+		for _, p := range result {
+			if p.Name != inc.Name {
+				continue
+			}
+
+			if !reflect.DeepEqual(p, inc) {
+				return nil, fmt.Errorf("%w: included query %s declares param %s differently", ErrParser, key, inc.Name)
+			}
+
+			found = true
+			break
+		}
+
+		if !found {
+			result = append(result, inc)
+		}
+	}
+
+	return result, nil
+}
+
+func includeKey(namespace, id string, revision int) string {
+	return fmt.Sprintf("%s/%s/%d", namespace, id, revision)
+}
+
+func containsKey(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+func prefixStatements(statements []domain.Statement, prefix string) []domain.Statement {
+	ids := make(map[string]bool)
+	for _, stmt := range statements {
+		ids[string(domain.NewResourceID(stmt))] = true
+	}
+
+	prefixID := func(id string) string {
+		if !ids[id] {
+			return id
+		}
+
+		return prefix + includeAliasSeparator + id
+	}
+
+	result := make([]domain.Statement, len(statements))
+	for i, stmt := range statements {
+		s := stmt
+		s.Alias = prefixID(string(domain.NewResourceID(stmt)))
+
+		if len(stmt.In) > 0 {
+			s.In = append([]string{prefixID(stmt.In[0])}, stmt.In[1:]...)
+		}
+
+		if len(stmt.DependsOn.Targets) > 0 {
+			s.DependsOn.Targets = make([]string, len(stmt.DependsOn.Targets))
+			for j, target := range stmt.DependsOn.Targets {
+				s.DependsOn.Targets[j] = prefixID(target)
+			}
+		}
+
+		if stmt.With.Values != nil {
+			values := make(map[string]interface{})
+			for k, v := range stmt.With.Values {
+				values[k] = prefixChainTarget(v, prefixID)
+			}
+			s.With.Values = values
+		}
+
+		if stmt.Headers != nil {
+			headers := make(map[string]interface{})
+			for k, v := range stmt.Headers {
+				headers[k] = prefixChainTarget(v, prefixID)
+			}
+			s.Headers = headers
+		}
+
+		if stmt.When != nil {
+			s.When = &domain.Condition{
+				Left:     prefixChainTarget(stmt.When.Left, prefixID),
+				Operator: stmt.When.Operator,
+				Right:    prefixChainTarget(stmt.When.Right, prefixID),
+			}
+		}
+
+		if stmt.OnError != nil {
+			s.OnError = &domain.OnError{Default: prefixChainTarget(stmt.OnError.Default, prefixID)}
+		}
+
+		result[i] = s
+	}
+
+	return result
+}
+
+func prefixChainTarget(value interface{}, prefixID func(string) string) interface{} {
+	switch value := value.(type) {
+	case domain.Chain:
+		target, ok := value[0].(string)
+		if !ok {
+			return value
+		}
+
+		chain := make(domain.Chain, len(value))
+		copy(chain, value)
+		chain[0] = prefixID(target)
+
+		return chain
+	case domain.DefaultVariable:
+		return domain.DefaultVariable{Target: value.Target, Default: prefixChainTarget(value.Default, prefixID)}
+	case domain.Template:
+		t := make(domain.Template, len(value))
+		for i, part := range value {
+			t[i] = prefixChainTarget(part, prefixID)
+		}
+		return t
+	case domain.Function:
+		return value.Map(func(target interface{}) interface{} {
+			return prefixChainTarget(target, prefixID)
+		})
+	case map[string]interface{}:
+		m := make(map[string]interface{})
+		for k, v := range value {
+			m[k] = prefixChainTarget(v, prefixID)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(value))
+		for i, v := range value {
+			l[i] = prefixChainTarget(v, prefixID)
+		}
+		return l
+	default:
+		return value
+	}
+}
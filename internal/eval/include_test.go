@@ -0,0 +1,153 @@
+package eval_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/internal/eval"
+	"github.com/b2wdigital/restQL-golang/v6/internal/parser"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+	"github.com/b2wdigital/restQL-golang/v6/test"
+)
+
+type stubQueryReader map[string]string
+
+func (s stubQueryReader) Get(ctx context.Context, namespace, id string, revision int) (restql.SavedQueryRevision, error) {
+	text, found := s[fmt.Sprintf("%s/%s/%d", namespace, id, revision)]
+	if !found {
+		return restql.SavedQueryRevision{}, restql.ErrQueryNotFound
+	}
+
+	return restql.SavedQueryRevision{Name: id, Text: text, Revision: revision}, nil
+}
+
+func TestResolveIncludes(t *testing.T) {
+	queryParser, err := parser.New()
+	test.VerifyError(t, err)
+
+	t.Run("should splice included statements prefixing their identifiers", func(t *testing.T) {
+		reader := stubQueryReader{
+			"common/checkout/1": `
+from customer
+	with id = $customerId
+
+from cart
+	depends-on customer
+	with customerId = customer.id
+
+from pricing in cart.pricing
+	with id = cart.items.id`,
+		}
+
+		query := domain.Query{
+			Include: []domain.Include{{Namespace: "common", Query: "checkout", Revision: 1, Alias: "ck"}},
+			Statements: []domain.Statement{
+				{Method: "from", Resource: "loyalty", With: domain.Params{Values: map[string]interface{}{"id": domain.Chain{"ck:customer", "id"}}}},
+			},
+		}
+
+		expected := domain.Query{
+			Statements: []domain.Statement{
+				{Method: "from", Resource: "customer", Alias: "ck:customer", With: domain.Params{Values: map[string]interface{}{"id": domain.Variable{Target: "customerId"}}}},
+				{Method: "from", Resource: "cart", Alias: "ck:cart", With: domain.Params{Values: map[string]interface{}{"customerId": domain.Chain{"ck:customer", "id"}}}, DependsOn: domain.DependsOn{Targets: []string{"ck:customer"}}},
+				{Method: "from", Resource: "pricing", Alias: "ck:pricing", In: []string{"ck:cart", "pricing"}, With: domain.Params{Values: map[string]interface{}{"id": domain.Chain{"ck:cart", "items", "id"}}}},
+				{Method: "from", Resource: "loyalty", With: domain.Params{Values: map[string]interface{}{"id": domain.Chain{"ck:customer", "id"}}}},
+			},
+		}
+
+		got, err := eval.ResolveIncludes(context.Background(), reader, queryParser, query, restql.QueryOptions{})
+
+		test.VerifyError(t, err)
+		test.Equal(t, got, expected)
+	})
+
+	t.Run("should resolve nested includes", func(t *testing.T) {
+		reader := stubQueryReader{
+			"common/customer/1": `from customer with id = $customerId`,
+			"common/checkout/2": `include common/customer/1 as c
+
+from cart with customerId = c:customer.id`,
+		}
+
+		query := domain.Query{Include: []domain.Include{{Namespace: "common", Query: "checkout", Revision: 2, Alias: "checkout"}}}
+
+		expected := domain.Query{
+			Statements: []domain.Statement{
+				{Method: "from", Resource: "customer", Alias: "checkout:c:customer", With: domain.Params{Values: map[string]interface{}{"id": domain.Variable{Target: "customerId"}}}},
+				{Method: "from", Resource: "cart", Alias: "checkout:cart", With: domain.Params{Values: map[string]interface{}{"customerId": domain.Chain{"checkout:c:customer", "id"}}}},
+			},
+		}
+
+		got, err := eval.ResolveIncludes(context.Background(), reader, queryParser, query, restql.QueryOptions{})
+
+		test.VerifyError(t, err)
+		test.Equal(t, got, expected)
+	})
+
+	t.Run("should fail when includes form a cycle", func(t *testing.T) {
+		reader := stubQueryReader{
+			"common/a/1": `include common/b/1`,
+			"common/b/1": `include common/a/1`,
+		}
+
+		query := domain.Query{
+			Include:    []domain.Include{{Namespace: "common", Query: "b", Revision: 1, Alias: "b"}},
+			Statements: []domain.Statement{{Method: "from", Resource: "hero"}},
+		}
+		opts := restql.QueryOptions{Namespace: "common", Id: "a", Revision: 1}
+
+		_, err := eval.ResolveIncludes(context.Background(), reader, queryParser, query, opts)
+
+		test.Equal(t, errors.Is(err, eval.ErrParser), true)
+		test.Equal(t, err.Error(), "parsing error: include cycle detected: common/a/1 -> common/b/1 -> common/a/1")
+	})
+
+	t.Run("should fail when included query does not exist", func(t *testing.T) {
+		query := domain.Query{Include: []domain.Include{{Namespace: "common", Query: "unknown", Revision: 1, Alias: "unknown"}}}
+
+		_, err := eval.ResolveIncludes(context.Background(), stubQueryReader{}, queryParser, query, restql.QueryOptions{})
+
+		test.Equal(t, errors.Is(err, restql.ErrQueryNotFound), true)
+		test.Equal(t, errors.Is(err, eval.ErrParser), false)
+	})
+
+	t.Run("should merge params declared by included queries", func(t *testing.T) {
+		reader := stubQueryReader{
+			"common/customer/1": `use params {customerId: int required, page: int = 1}
+from customer with id = $customerId`,
+		}
+
+		query := domain.Query{
+			Params:  []domain.Param{{Name: "customerId", Type: "int", Required: true}},
+			Include: []domain.Include{{Namespace: "common", Query: "customer", Revision: 1, Alias: "c"}},
+		}
+
+		got, err := eval.ResolveIncludes(context.Background(), reader, queryParser, query, restql.QueryOptions{})
+
+		test.VerifyError(t, err)
+		test.Equal(t, got.Params, []domain.Param{
+			{Name: "customerId", Type: "int", Required: true},
+			{Name: "page", Type: "int", Default: 1},
+		})
+	})
+
+	t.Run("should fail when included query declares a param differently", func(t *testing.T) {
+		reader := stubQueryReader{
+			"common/customer/1": `use params {customerId: string}
+from customer with id = $customerId`,
+		}
+
+		query := domain.Query{
+			Params:  []domain.Param{{Name: "customerId", Type: "int", Required: true}},
+			Include: []domain.Include{{Namespace: "common", Query: "customer", Revision: 1, Alias: "c"}},
+		}
+
+		_, err := eval.ResolveIncludes(context.Background(), reader, queryParser, query, restql.QueryOptions{})
+
+		test.Equal(t, errors.Is(err, eval.ErrParser), true)
+		test.Equal(t, err.Error(), "parsing error: included query common/customer/1 declares param customerId differently")
+	})
+}
@@ -0,0 +1,302 @@
+package eval
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/internal/runner"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+)
+
+// Severity levels of the issues found by Lint.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityInfo    = "info"
+)
+
+// Rules checked by Lint.
+const (
+	RuleUnmappedResource      = "unmapped-resource"
+	RuleUnknownInTarget       = "unknown-in-target"
+	RuleUnknownDependsOn      = "unknown-depends-on-target"
+	RuleDependencyCycle       = "dependency-cycle"
+	RuleAliasShadowing        = "alias-shadows-resource"
+	RuleUnreferencedHidden    = "unreferenced-hidden"
+	RuleHiddenWithFilters     = "hidden-with-filters"
+	RuleUndocumentedVariables = "undocumented-variable"
+)
+
+// LintIssue represents a semantic problem found on a query.
+// Statement is the resource identifier of the offending
+// statement, empty when the issue concerns the whole query.
+type LintIssue struct {
+	Severity  string
+	Rule      string
+	Statement string
+	Message   string
+}
+
+// Lint checks a valid query for semantic problems that
+// the parser can not detect, like references to statements
+// that do not exist or that form a cycle.
+// Mappings are optional, when given the statements are also
+// checked against the tenant resources.
+func Lint(query domain.Query, mappings map[string]restql.Mapping) []LintIssue {
+	ids := make(map[string]bool)
+	resources := make(map[string]bool)
+	for _, stmt := range query.Statements {
+		ids[string(domain.NewResourceID(stmt))] = true
+		resources[stmt.Resource] = true
+	}
+
+	dependencies := make(map[string][]string)
+	referenced := make(map[string]bool)
+	for _, stmt := range query.Statements {
+		id := string(domain.NewResourceID(stmt))
+		for _, target := range runner.StatementDependencies(stmt) {
+			if !ids[target] {
+				continue
+			}
+
+			dependencies[id] = append(dependencies[id], target)
+			if target != id {
+				referenced[target] = true
+			}
+		}
+
+		if len(stmt.In) > 0 && stmt.In[0] != id {
+			referenced[stmt.In[0]] = true
+		}
+	}
+
+	var issues []LintIssue
+	for _, stmt := range query.Statements {
+		id := string(domain.NewResourceID(stmt))
+		issue := func(severity, rule, format string, args ...interface{}) {
+			issues = append(issues, LintIssue{Severity: severity, Rule: rule, Statement: id, Message: fmt.Sprintf(format, args...)})
+		}
+
+		if _, found := mappings[stmt.Resource]; mappings != nil && !found {
+			issue(SeverityError, RuleUnmappedResource, "resource %s is not mapped", stmt.Resource)
+		}
+
+		if len(stmt.In) > 0 && !ids[stmt.In[0]] {
+			issue(SeverityError, RuleUnknownInTarget, "in target %s does not exist", stmt.In[0])
+		}
+
+		for _, target := range stmt.DependsOn.Targets {
+			if !ids[target] {
+				issue(SeverityError, RuleUnknownDependsOn, "depends-on target %s does not exist", target)
+			}
+		}
+
+		if stmt.Alias != "" && stmt.Alias != stmt.Resource {
+			_, mapped := mappings[stmt.Alias]
+			if resources[stmt.Alias] || mapped {
+				issue(SeverityWarning, RuleAliasShadowing, "alias %s shadows the resource with the same name", stmt.Alias)
+			}
+		}
+
+		if stmt.Hidden && !referenced[id] {
+			issue(SeverityWarning, RuleUnreferencedHidden, "hidden statement is not referenced by any other statement")
+		}
+
+		if stmt.Hidden && (len(stmt.Only) > 0 || len(stmt.Exclude) > 0) {
+			issue(SeverityWarning, RuleHiddenWithFilters, "only and exclude have no effect on a hidden statement")
+		}
+	}
+
+	for _, cycle := range findCycles(query.Statements, dependencies) {
+		issues = append(issues, LintIssue{
+			Severity:  SeverityError,
+			Rule:      RuleDependencyCycle,
+			Statement: cycle[0],
+			Message:   fmt.Sprintf("statements depend on each other: %s", strings.Join(cycle, " -> ")),
+		})
+	}
+
+	for _, name := range undocumentedVariables(query) {
+		issues = append(issues, LintIssue{
+			Severity: SeverityInfo,
+			Rule:     RuleUndocumentedVariables,
+			Message:  fmt.Sprintf("variable %s is not declared, required nor has a default value", name),
+		})
+	}
+
+	return issues
+}
+
+// findCycles returns each dependency cycle once, as the path
+// starting and ending on the first statement of the cycle
+// declared in the query.
+func findCycles(statements []domain.Statement, dependencies map[string][]string) [][]string {
+	const (
+		visiting = 1
+		done     = 2
+	)
+
+	state := make(map[string]int)
+	reported := make(map[string]bool)
+	var cycles [][]string
+	var path []string
+
+	var visit func(id string)
+	visit = func(id string) {
+		state[id] = visiting
+		path = append(path, id)
+
+		for _, dep := range dependencies[id] {
+			switch state[dep] {
+			case visiting:
+				cycle := cyclePath(path, dep)
+				key := cycleKey(cycle)
+				if !reported[key] {
+					reported[key] = true
+					cycles = append(cycles, cycle)
+				}
+			case 0:
+				visit(dep)
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[id] = done
+	}
+
+	for _, stmt := range statements {
+		id := string(domain.NewResourceID(stmt))
+		if state[id] == 0 {
+			visit(id)
+		}
+	}
+
+	return cycles
+}
+
+func cyclePath(path []string, start string) []string {
+	var cycle []string
+	for i := len(path) - 1; i >= 0; i-- {
+		if path[i] == start {
+			cycle = append(cycle, path[i:]...)
+			break
+		}
+	}
+
+	return append(cycle, start)
+}
+
+func cycleKey(cycle []string) string {
+	members := make([]string, len(cycle)-1)
+	copy(members, cycle)
+	sort.Strings(members)
+
+	return strings.Join(members, ",")
+}
+
+// undocumentedVariables returns the name of the variables
+// not declared on the `use params` clause and never marked
+// as required nor given a default value, meaning the query
+// does not state what happens when the client does not send them.
+func undocumentedVariables(query domain.Query) []string {
+	used := make(map[string]bool)
+	documented := make(map[string]bool)
+	for _, p := range query.Params {
+		documented[p.Name] = true
+	}
+	for _, stmt := range query.Statements {
+		for _, value := range statementValues(stmt) {
+			visitValues(value, func(v interface{}) {
+				switch v := v.(type) {
+				case domain.Variable:
+					used[v.Target] = true
+				case domain.RequiredVariable:
+					documented[v.Target] = true
+				case domain.DefaultVariable:
+					documented[v.Target] = true
+				}
+			})
+		}
+	}
+
+	var names []string
+	for name := range used {
+		if !documented[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func statementValues(stmt domain.Statement) []interface{} {
+	values := []interface{}{
+		stmt.With.Body,
+		stmt.Timeout,
+		stmt.CacheControl.MaxAge,
+		stmt.CacheControl.SMaxAge,
+	}
+
+	for _, v := range stmt.With.Values {
+		values = append(values, v)
+	}
+
+	for _, v := range stmt.Headers {
+		values = append(values, v)
+	}
+
+	for _, v := range stmt.Only {
+		values = append(values, v)
+	}
+
+	if stmt.When != nil {
+		values = append(values, stmt.When.Left, stmt.When.Right)
+	}
+
+	if stmt.Paginate != nil {
+		values = append(values, stmt.Paginate.MaxPages)
+	}
+
+	if stmt.Retry != nil {
+		values = append(values, stmt.Retry.Attempts, stmt.Retry.Backoff)
+	}
+
+	if stmt.OnError != nil {
+		values = append(values, stmt.OnError.Default)
+	}
+
+	return values
+}
+
+func visitValues(value interface{}, visit func(v interface{})) {
+	visit(value)
+
+	switch value := value.(type) {
+	case domain.DefaultVariable:
+		visitValues(value.Default, visit)
+	case domain.Function:
+		visitValues(value.Target(), visit)
+		for _, arg := range value.Arguments() {
+			visitValues(arg.Value, visit)
+		}
+	case domain.Chain:
+		for _, v := range value {
+			visitValues(v, visit)
+		}
+	case domain.Template:
+		for _, v := range value {
+			visitValues(v, visit)
+		}
+	case map[string]interface{}:
+		for _, v := range value {
+			visitValues(v, visit)
+		}
+	case []interface{}:
+		for _, v := range value {
+			visitValues(v, visit)
+		}
+	}
+}
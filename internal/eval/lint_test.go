@@ -0,0 +1,116 @@
+package eval_test
+
+import (
+	"testing"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/internal/eval"
+	"github.com/b2wdigital/restQL-golang/v6/internal/parser"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+	"github.com/b2wdigital/restQL-golang/v6/test"
+)
+
+func TestLint(t *testing.T) {
+	queryParser, err := parser.New()
+	test.VerifyError(t, err)
+
+	heroMapping, err := restql.NewMapping("hero", "http://hero.api/")
+	test.VerifyError(t, err)
+	sidekickMapping, err := restql.NewMapping("sidekick", "http://sidekick.api/")
+	test.VerifyError(t, err)
+	mappings := map[string]restql.Mapping{"hero": heroMapping, "sidekick": sidekickMapping}
+
+	tests := []struct {
+		name     string
+		query    string
+		mappings map[string]restql.Mapping
+		expected []eval.LintIssue
+	}{
+		{
+			"query without issues",
+			"from hero with id = $id!\nfrom sidekick with heroId = hero.id",
+			mappings,
+			nil,
+		},
+		{
+			"resource not mapped on tenant",
+			"from villain",
+			mappings,
+			[]eval.LintIssue{{Severity: eval.SeverityError, Rule: eval.RuleUnmappedResource, Statement: "villain", Message: "resource villain is not mapped"}},
+		},
+		{
+			"mappings are not checked without tenant",
+			"from villain",
+			nil,
+			nil,
+		},
+		{
+			"in and depends-on targets that do not exist",
+			"from sidekick in villain.sidekick depends-on villain",
+			nil,
+			[]eval.LintIssue{
+				{Severity: eval.SeverityError, Rule: eval.RuleUnknownInTarget, Statement: "sidekick", Message: "in target villain does not exist"},
+				{Severity: eval.SeverityError, Rule: eval.RuleUnknownDependsOn, Statement: "sidekick", Message: "depends-on target villain does not exist"},
+			},
+		},
+		{
+			"alias shadowing resource name",
+			"from hero as sidekick\nfrom sidekick as robin with id = sidekick.id\nfrom hero as villain",
+			mappings,
+			[]eval.LintIssue{{Severity: eval.SeverityWarning, Rule: eval.RuleAliasShadowing, Statement: "sidekick", Message: "alias sidekick shadows the resource with the same name"}},
+		},
+		{
+			"hidden statement not referenced",
+			"from hero hidden\nfrom sidekick hidden\nfrom villain with id = sidekick.id",
+			nil,
+			[]eval.LintIssue{{Severity: eval.SeverityWarning, Rule: eval.RuleUnreferencedHidden, Statement: "hero", Message: "hidden statement is not referenced by any other statement"}},
+		},
+		{
+			"statements depending on each other",
+			"from hero with id = villain.id\nfrom sidekick depends-on hero\nfrom villain with id = sidekick.id\nfrom loyalty with id = loyalty.id",
+			nil,
+			[]eval.LintIssue{
+				{Severity: eval.SeverityError, Rule: eval.RuleDependencyCycle, Statement: "hero", Message: "statements depend on each other: hero -> villain -> sidekick -> hero"},
+				{Severity: eval.SeverityError, Rule: eval.RuleDependencyCycle, Statement: "loyalty", Message: "statements depend on each other: loyalty -> loyalty"},
+			},
+		},
+		{
+			"statement aggregated in the statement it depends on",
+			"from hero in villain.hero\nfrom villain with id = hero.id\nfrom sidekick hidden\nfrom robin in sidekick.robin",
+			nil,
+			nil,
+		},
+		{
+			"variables without required mark or default value",
+			"use params {id: int}\nfrom hero headers X-Id = $traceId with id = $id, name = \"${$name}\", page = $page ?: 1\nfrom sidekick with id = $id!, size = $size only items -> take($size)",
+			nil,
+			[]eval.LintIssue{
+				{Severity: eval.SeverityInfo, Rule: eval.RuleUndocumentedVariables, Message: "variable name is not declared, required nor has a default value"},
+				{Severity: eval.SeverityInfo, Rule: eval.RuleUndocumentedVariables, Message: "variable size is not declared, required nor has a default value"},
+				{Severity: eval.SeverityInfo, Rule: eval.RuleUndocumentedVariables, Message: "variable traceId is not declared, required nor has a default value"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := queryParser.Parse(tt.query)
+			test.VerifyError(t, err)
+
+			got := eval.Lint(query, tt.mappings)
+			test.Equal(t, got, tt.expected)
+		})
+	}
+
+	t.Run("filters on hidden statement", func(t *testing.T) {
+		query := domain.Query{Statements: []domain.Statement{
+			{Method: "from", Resource: "hero", Hidden: true, Only: []interface{}{[]string{"name"}}},
+			{Method: "from", Resource: "sidekick", With: domain.Params{Values: map[string]interface{}{"id": domain.Chain{"hero", "id"}}}},
+		}}
+
+		expected := []eval.LintIssue{{Severity: eval.SeverityWarning, Rule: eval.RuleHiddenWithFilters, Statement: "hero", Message: "only and exclude have no effect on a hidden statement"}}
+
+		got := eval.Lint(query, nil)
+		test.Equal(t, got, expected)
+	})
+}
@@ -0,0 +1,97 @@
+package eval
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+)
+
+// ParamViolation describes why a value sent by the client
+// does not comply with the parameter declaration.
+type ParamViolation struct {
+	Param   string
+	Message string
+}
+
+// ParamsError is returned when the client input does not comply
+// with the parameters declared by the query, listing every violation.
+type ParamsError struct {
+	Violations []ParamViolation
+}
+
+func (pe ParamsError) Error() string {
+	messages := make([]string, len(pe.Violations))
+	for i, v := range pe.Violations {
+		messages[i] = v.Param + " " + v.Message
+	}
+
+	return fmt.Sprintf("%s: invalid params: %s", ErrValidation, strings.Join(messages, "; "))
+}
+
+// Is allows ParamsError to be identified as ErrValidation.
+func (pe ParamsError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// ValidateParams checks the client input against the parameters
+// declared on the `use params` clause, returning the input with
+// the values converted to the declared types and the default
+// values of the missing parameters.
+func ValidateParams(params []domain.Param, input restql.QueryInput) (restql.QueryInput, error) {
+	if len(params) == 0 {
+		return input, nil
+	}
+
+	values := make(map[string]interface{}, len(input.Params))
+	for k, v := range input.Params {
+		values[k] = v
+	}
+
+	body, isObjectBody := input.Body.(map[string]interface{})
+	if isObjectBody {
+		b := make(map[string]interface{}, len(body))
+		for k, v := range body {
+			b[k] = v
+		}
+		body = b
+	}
+
+	var violations []ParamViolation
+	for _, p := range params {
+		value, found := getUniqueParamValue(p.Name, input)
+		if !found {
+			switch {
+			case p.Required:
+				violations = append(violations, ParamViolation{Param: p.Name, Message: "is required"})
+			case p.Default != nil:
+				values[p.Name] = p.Default
+			}
+			continue
+		}
+
+		coerced, err := p.Coerce(value)
+		if err != nil {
+			violations = append(violations, ParamViolation{Param: p.Name, Message: err.Error()})
+			continue
+		}
+
+		if _, inBody := body[p.Name]; inBody {
+			body[p.Name] = coerced
+		} else {
+			values[p.Name] = coerced
+		}
+	}
+
+	if len(violations) > 0 {
+		return restql.QueryInput{}, ParamsError{Violations: violations}
+	}
+
+	input.Params = values
+	if isObjectBody {
+		input.Body = body
+	}
+
+	return input, nil
+}
@@ -0,0 +1,90 @@
+package eval_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/internal/eval"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+	"github.com/b2wdigital/restQL-golang/v6/test"
+)
+
+func TestValidateParams(t *testing.T) {
+	params := []domain.Param{
+		{Name: "id", Type: domain.IntParam, Required: true},
+		{Name: "page", Type: domain.IntParam, Default: 1},
+		{Name: "sort", Type: domain.EnumParam, Enum: []string{"asc", "desc"}},
+		{Name: "tags", Type: domain.ListParam},
+		{Name: "active", Type: domain.BooleanParam},
+	}
+
+	t.Run("should return input unchanged when query declares no params", func(t *testing.T) {
+		input := restql.QueryInput{Params: map[string]interface{}{"id": "abc"}}
+
+		got, err := eval.ValidateParams(nil, input)
+
+		test.VerifyError(t, err)
+		test.Equal(t, got, input)
+	})
+
+	t.Run("should coerce values and apply defaults", func(t *testing.T) {
+		input := restql.QueryInput{
+			Params:  map[string]interface{}{"id": "10", "sort": "desc", "tags": "a", "other": "x"},
+			Headers: map[string]string{"Active": "true"},
+		}
+
+		expected := restql.QueryInput{
+			Params: map[string]interface{}{
+				"id":     10,
+				"page":   1,
+				"sort":   "desc",
+				"tags":   []interface{}{"a"},
+				"active": true,
+				"other":  "x",
+			},
+			Headers: map[string]string{"Active": "true"},
+		}
+
+		got, err := eval.ValidateParams(params, input)
+
+		test.VerifyError(t, err)
+		test.Equal(t, got, expected)
+	})
+
+	t.Run("should coerce values sent on body", func(t *testing.T) {
+		input := restql.QueryInput{Body: map[string]interface{}{"id": 10.0, "page": 2.0}}
+
+		expected := restql.QueryInput{
+			Params: map[string]interface{}{},
+			Body:   map[string]interface{}{"id": 10, "page": 2},
+		}
+
+		got, err := eval.ValidateParams(params, input)
+
+		test.VerifyError(t, err)
+		test.Equal(t, got, expected)
+	})
+
+	t.Run("should return every violation", func(t *testing.T) {
+		input := restql.QueryInput{Params: map[string]interface{}{"page": "first", "sort": "random", "active": []interface{}{"true", "false"}}}
+
+		expected := eval.ParamsError{Violations: []eval.ParamViolation{
+			{Param: "id", Message: "is required"},
+			{Param: "page", Message: "must be an int"},
+			{Param: "sort", Message: `must be one of "asc", "desc"`},
+			{Param: "active", Message: "must be a single boolean value"},
+		}}
+
+		_, err := eval.ValidateParams(params, input)
+
+		var got eval.ParamsError
+		if !errors.As(err, &got) {
+			t.Fatalf("expected a params error, got %v", err)
+		}
+
+		test.Equal(t, got, expected)
+		test.Equal(t, errors.Is(err, eval.ErrValidation), true)
+		test.Equal(t, err.Error(), `validation error: invalid params: id is required; page must be an int; sort must be one of "asc", "desc"; active must be a single boolean value`)
+	})
+}
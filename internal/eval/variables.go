@@ -2,9 +2,12 @@ package eval
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
 	"net/http"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
 )
@@ -18,15 +21,76 @@ func ResolveVariables(query domain.Query, input restql.QueryInput) domain.Query
 	for i, stmt := range query.Statements {
 		copyStmt := stmt
 		copyStmt.With = resolveWith(copyStmt.With, input)
-		copyStmt.Timeout = resolveTimeout(copyStmt.Timeout, input)
+		copyStmt.Timeout = resolveIntValue(copyStmt.Timeout, input)
 		copyStmt.Headers = resolveHeaders(copyStmt.Headers, input)
 		copyStmt.CacheControl = resolveCacheControl(copyStmt.CacheControl, input)
 		copyStmt.Only = resolveOnly(copyStmt.Only, input)
+		copyStmt.When = resolveCondition(copyStmt.When, input)
+		copyStmt.Paginate = resolvePagination(copyStmt.Paginate, input)
+		copyStmt.Retry = resolveRetry(copyStmt.Retry, input)
+		copyStmt.OnError = resolveOnError(copyStmt.OnError, input)
 
 		result[i] = copyStmt
 	}
 
-	return domain.Query{Use: query.Use, Statements: result}
+	return domain.Query{Use: query.Use, Params: query.Params, Statements: result}
+}
+
+// ValidateRequiredVariables checks if every variable marked
+// as required in the query is present in the client body,
+// query parameters or headers.
+// Variables used as hmac-sha256 secrets are always required,
+// and must resolve to a string or a number.
+func ValidateRequiredVariables(query domain.Query, input restql.QueryInput) error {
+	required := make(map[string]bool)
+	var secrets []interface{}
+	for _, stmt := range query.Statements {
+		for _, value := range statementValues(stmt) {
+			visitValues(value, func(v interface{}) {
+				switch v := v.(type) {
+				case domain.RequiredVariable:
+					required[v.Target] = true
+				case domain.HMACSHA256:
+					secret := v.Argument(domain.HMACSHA256ArgSecret).Value
+					if variable, ok := secret.(domain.Variable); ok {
+						required[variable.Target] = true
+					}
+
+					secrets = append(secrets, secret)
+				}
+			})
+		}
+	}
+
+	var missing []string
+	for name := range required {
+		if _, found := getUniqueParamValue(name, input); !found {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("%w: required variables not provided: %s", ErrValidation, strings.Join(missing, ", "))
+	}
+
+	for _, secret := range secrets {
+		value, _ := resolveWithParamValue(secret, input)
+		if !isSecretValue(value) {
+			return fmt.Errorf("%w: hmac-sha256 secret must be a string or a number, got %v", ErrValidation, value)
+		}
+	}
+
+	return nil
+}
+
+func isSecretValue(value interface{}) bool {
+	switch value.(type) {
+	case string, int, float64:
+		return true
+	default:
+		return false
+	}
 }
 
 func resolveWith(with domain.Params, input restql.QueryInput) domain.Params {
@@ -54,12 +118,18 @@ func resolveWithParamValue(value interface{}, input restql.QueryInput) (interfac
 	switch value := value.(type) {
 	case domain.Variable:
 		return getUniqueParamValue(value.Target, input)
+	case domain.RequiredVariable:
+		return getUniqueParamValue(value.Target, input)
+	case domain.DefaultVariable:
+		return resolveDefaultVariable(value, input)
 	case domain.Chain:
 		return resolveChain(value, input)
+	case domain.Template:
+		return resolveTemplate(value, input)
 	case domain.Function:
 		v, ok := resolveWithParamValue(value.Target(), input)
 		fnValue := value.Map(func(target interface{}) interface{} { return v })
-		return fnValue, ok
+		return resolveArguments(fnValue, input), ok
 	case map[string]interface{}:
 		return resolveComplexWithParam(value, input), true
 	case []interface{}:
@@ -69,20 +139,21 @@ func resolveWithParamValue(value interface{}, input restql.QueryInput) (interfac
 	}
 }
 
+func resolveDefaultVariable(variable domain.DefaultVariable, input restql.QueryInput) (interface{}, bool) {
+	paramValue, found := getUniqueParamValue(variable.Target, input)
+	if found {
+		return paramValue, true
+	}
+
+	return resolveWithParamValue(variable.Default, input)
+}
+
 func resolveWithBody(body interface{}, input restql.QueryInput) interface{} {
 	switch body := body.(type) {
 	case domain.Variable:
-		p, found := getUniqueParamValue(body.Target, input)
-		if !found {
-			return nil
-		}
-
-		b, err := unmarshalValue(p)
-		if err != nil {
-			return p
-		}
-
-		return b
+		return resolveBodyVariable(body.Target, input)
+	case domain.RequiredVariable:
+		return resolveBodyVariable(body.Target, input)
 	case domain.Function:
 		return body.Map(func(target interface{}) interface{} {
 			return resolveWithBody(target, input)
@@ -92,6 +163,20 @@ func resolveWithBody(body interface{}, input restql.QueryInput) interface{} {
 	}
 }
 
+func resolveBodyVariable(target string, input restql.QueryInput) interface{} {
+	p, found := getUniqueParamValue(target, input)
+	if !found {
+		return nil
+	}
+
+	b, err := unmarshalValue(p)
+	if err != nil {
+		return p
+	}
+
+	return b
+}
+
 func unmarshalValue(value interface{}) (interface{}, error) {
 	switch value := value.(type) {
 	case string:
@@ -198,6 +283,20 @@ func resolveHeaders(headers map[string]interface{}, input restql.QueryInput) map
 				continue
 			}
 
+			result[key] = paramValue
+		case domain.RequiredVariable:
+			paramValue, ok := getUniqueParamValue(value.Target, input)
+			if !ok {
+				continue
+			}
+
+			result[key] = paramValue
+		case domain.DefaultVariable:
+			paramValue, ok := resolveDefaultVariable(value, input)
+			if !ok {
+				continue
+			}
+
 			result[key] = paramValue
 		case domain.Chain:
 			rc, ok := resolveChain(value, input)
@@ -206,6 +305,13 @@ func resolveHeaders(headers map[string]interface{}, input restql.QueryInput) map
 			}
 
 			result[key] = rc
+		case domain.Template:
+			rt, ok := resolveTemplate(value, input)
+			if !ok {
+				continue
+			}
+
+			result[key] = rt
 		case string:
 			result[key] = value
 		}
@@ -214,10 +320,10 @@ func resolveHeaders(headers map[string]interface{}, input restql.QueryInput) map
 	return result
 }
 
-func resolveTimeout(timeout interface{}, input restql.QueryInput) interface{} {
-	switch timeout := timeout.(type) {
+func resolveIntValue(value interface{}, input restql.QueryInput) interface{} {
+	switch value := value.(type) {
 	case domain.Variable:
-		paramValue, found := getUniqueParamValue(timeout.Target, input)
+		paramValue, found := getUniqueParamValue(value.Target, input)
 		if !found {
 			return nil
 		}
@@ -229,12 +335,56 @@ func resolveTimeout(timeout interface{}, input restql.QueryInput) interface{} {
 
 		return result
 	case int:
-		return timeout
+		return value
 	default:
 		return nil
 	}
 }
 
+func resolveTemplate(template domain.Template, input restql.QueryInput) (interface{}, bool) {
+	result := make(domain.Template, len(template))
+	for i, part := range template {
+		switch part := part.(type) {
+		case domain.Variable:
+			paramValue, found := getUniqueParamValue(part.Target, input)
+			if !found {
+				return nil, false
+			}
+
+			result[i] = paramValue
+		case domain.RequiredVariable:
+			paramValue, found := getUniqueParamValue(part.Target, input)
+			if !found {
+				return nil, false
+			}
+
+			result[i] = paramValue
+		case domain.DefaultVariable:
+			paramValue, found := resolveDefaultVariable(part, input)
+			if !found {
+				return nil, false
+			}
+
+			result[i] = paramValue
+		case domain.Chain:
+			chain, ok := resolveChain(part, input)
+			if !ok {
+				return nil, false
+			}
+
+			result[i] = chain
+		default:
+			result[i] = part
+		}
+	}
+
+	if rendered, ok := result.Render(); ok {
+		return rendered, true
+	}
+
+	return result, true
+}
+
 func resolveChain(chain domain.Chain, input restql.QueryInput) (domain.Chain, bool) {
 	result := make(domain.Chain, len(chain))
 	for i, pathItem := range chain {
@@ -254,6 +404,63 @@ func resolveChain(chain domain.Chain, input restql.QueryInput) (domain.Chain, bo
 	return result, true
 }
 
+func resolveCondition(condition *domain.Condition, input restql.QueryInput) *domain.Condition {
+	if condition == nil {
+		return nil
+	}
+
+	return &domain.Condition{
+		Left:     resolveConditionOperand(condition.Left, input),
+		Operator: condition.Operator,
+		Right:    resolveConditionOperand(condition.Right, input),
+	}
+}
+
+func resolveConditionOperand(operand interface{}, input restql.QueryInput) interface{} {
+	value, ok := resolveWithParamValue(operand, input)
+	if !ok {
+		return nil
+	}
+
+	return value
+}
+
+func resolvePagination(pagination *domain.Pagination, input restql.QueryInput) *domain.Pagination {
+	if pagination == nil {
+		return nil
+	}
+
+	p := *pagination
+	p.MaxPages = resolveIntValue(pagination.MaxPages, input)
+
+	return &p
+}
+
+func resolveRetry(retry *domain.Retry, input restql.QueryInput) *domain.Retry {
+	if retry == nil {
+		return nil
+	}
+
+	return &domain.Retry{
+		Attempts: resolveIntValue(retry.Attempts, input),
+		Backoff:  resolveIntValue(retry.Backoff, input),
+		Unsafe:   retry.Unsafe,
+	}
+}
+
+func resolveOnError(onError *domain.OnError, input restql.QueryInput) *domain.OnError {
+	if onError == nil {
+		return nil
+	}
+
+	value, ok := resolveWithParamValue(onError.Default, input)
+	if !ok {
+		return &domain.OnError{}
+	}
+
+	return &domain.OnError{Default: value}
+}
+
 func resolveOnly(only []interface{}, input restql.QueryInput) []interface{} {
 	if only == nil {
 		return nil
@@ -273,12 +480,23 @@ func resolveOnly(only []interface{}, input restql.QueryInput) []interface{} {
 }
 
 func resolveFunction(fn domain.Function, input restql.QueryInput) domain.Function {
-	args := fn.Arguments()
-	resolvedFn := fn
+	resolvedFn := fn.Map(func(target interface{}) interface{} {
+		if inner, ok := target.(domain.Function); ok {
+			return resolveFunction(inner, input)
+		}
+
+		return target
+	})
 
-	for _, arg := range args {
-		if argValue, ok := arg.Value.(domain.Variable); ok {
-			resolvedArg, found := getUniqueParamValue(argValue.Target, input)
+	return resolveArguments(resolvedFn, input)
+}
+
+func resolveArguments(fn domain.Function, input restql.QueryInput) domain.Function {
+	resolvedFn := fn
+	for _, arg := range fn.Arguments() {
+		switch arg.Value.(type) {
+		case domain.Variable, domain.RequiredVariable, domain.DefaultVariable:
+			resolvedArg, found := resolveWithParamValue(arg.Value, input)
 			if !found {
 				continue
 			}
@@ -1,6 +1,7 @@
 package eval_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/b2wdigital/restQL-golang/v6/internal/eval"
@@ -273,6 +274,127 @@ func TestResolveVariables(t *testing.T) {
 				domain.Match{Value: "name", Args: []domain.Arg{{Name: domain.MatchArgRegex, Value: "^Super"}}},
 			}}}},
 		},
+		{
+			"resolve variable in when clause",
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "loyalty", When: &domain.Condition{
+				Left:     domain.Variable{Target: "customerType"},
+				Operator: domain.NotEqualOperator,
+				Right:    domain.Variable{Target: "excludedType"},
+			}}}},
+			restql.QueryInput{Params: map[string]interface{}{"customerType": "vip"}},
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "loyalty", When: &domain.Condition{
+				Left:     "vip",
+				Operator: domain.NotEqualOperator,
+				Right:    nil,
+			}}}},
+		},
+		{
+			"resolve required and default variables",
+			domain.Query{Statements: []domain.Statement{{
+				Method:   "from",
+				Resource: "hero",
+				Headers: map[string]interface{}{
+					"Authorization": domain.RequiredVariable{Target: "token"},
+					"X-Trace-Id":    domain.DefaultVariable{Target: "traceId", Default: "none"},
+				},
+				With: domain.Params{Values: map[string]interface{}{
+					"id":   domain.RequiredVariable{Target: "id"},
+					"page": domain.DefaultVariable{Target: "page", Default: 1},
+					"size": domain.DefaultVariable{Target: "size", Default: domain.Variable{Target: "defaultSize"}},
+					"sort": domain.DefaultVariable{Target: "sort", Default: "name"},
+				}},
+			}}},
+			restql.QueryInput{
+				Params:  map[string]interface{}{"id": "1234", "sort": "age", "defaultSize": "20"},
+				Headers: map[string]string{"Token": "abc"},
+			},
+			domain.Query{Statements: []domain.Statement{{
+				Method:   "from",
+				Resource: "hero",
+				Headers:  map[string]interface{}{"Authorization": "abc", "X-Trace-Id": "none"},
+				With: domain.Params{Values: map[string]interface{}{
+					"id":   "1234",
+					"page": 1,
+					"size": "20",
+					"sort": "age",
+				}},
+			}}},
+		},
+		{
+			"resolve variable in function wrapped by rename",
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "hero", Only: []interface{}{
+				domain.Rename{Value: domain.Match{Value: []string{"name"}, Args: []domain.Arg{{Name: domain.MatchArgRegex, Value: domain.Variable{Target: "pattern"}}}}, Alias: "heroName"},
+			}}}},
+			restql.QueryInput{Params: map[string]interface{}{"pattern": "^bat"}},
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "hero", Only: []interface{}{
+				domain.Rename{Value: domain.Match{Value: []string{"name"}, Args: []domain.Arg{{Name: domain.MatchArgRegex, Value: "^bat"}}}, Alias: "heroName"},
+			}}}},
+		},
+		{
+			"resolve variables in nested list functions",
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "product", Only: []interface{}{
+				domain.NewTake(domain.NewSortBy([]string{"offers"}, domain.Variable{Target: "sort"}, domain.Variable{Target: "order"}), domain.Variable{Target: "size"}),
+			}}}},
+			restql.QueryInput{Params: map[string]interface{}{"sort": "price", "order": "desc", "size": "5"}},
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "product", Only: []interface{}{
+				domain.NewTake(domain.NewSortBy([]string{"offers"}, "price", "desc"), "5"),
+			}}}},
+		},
+		{
+			"resolve variable in pagination limit",
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "product", Paginate: &domain.Pagination{Param: "cursor", Path: []string{"next"}, MaxPages: domain.Variable{Target: "pages"}}}}},
+			restql.QueryInput{Params: map[string]interface{}{"pages": "3"}},
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "product", Paginate: &domain.Pagination{Param: "cursor", Path: []string{"next"}, MaxPages: 3}}}},
+		},
+		{
+			"resolve variables in retry",
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "hero", Retry: &domain.Retry{Attempts: domain.Variable{Target: "attempts"}, Backoff: 50}}}},
+			restql.QueryInput{Params: map[string]interface{}{"attempts": "2"}},
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "hero", Retry: &domain.Retry{Attempts: 2, Backoff: 50}}}},
+		},
+		{
+			"resolve variables in on-error default value",
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "hero", OnError: &domain.OnError{Default: map[string]interface{}{"name": domain.Variable{Target: "name"}, "items": []interface{}{}}}}}},
+			restql.QueryInput{Params: map[string]interface{}{"name": "batman"}},
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "hero", OnError: &domain.OnError{Default: map[string]interface{}{"name": "batman", "items": []interface{}{}}}}}},
+		},
+		{
+			"resolve variables in template strings",
+			domain.Query{Statements: []domain.Statement{{
+				Method:   "from",
+				Resource: "hero",
+				Headers:  map[string]interface{}{"Authorization": domain.Template{"Bearer ", domain.Variable{Target: "token"}}},
+				With: domain.Params{Values: map[string]interface{}{
+					"id":      domain.Template{domain.Chain{"done-resource", "id"}, "-", domain.Variable{Target: "suffix"}},
+					"page":    domain.Template{"page-", domain.Variable{Target: "page"}},
+					"missing": domain.Template{"id-", domain.Variable{Target: "unknown"}},
+					"name":    domain.Template{"hero-", domain.RequiredVariable{Target: "page"}},
+					"sort":    domain.Template{"by-", domain.DefaultVariable{Target: "sort", Default: "name"}},
+				}},
+			}}},
+			restql.QueryInput{Params: map[string]interface{}{"token": "abc", "suffix": "x", "page": 2}},
+			domain.Query{Statements: []domain.Statement{{
+				Method:   "from",
+				Resource: "hero",
+				Headers:  map[string]interface{}{"Authorization": "Bearer abc"},
+				With: domain.Params{Values: map[string]interface{}{
+					"id":   domain.Template{domain.Chain{"done-resource", "id"}, "-", "x"},
+					"page": "page-2",
+					"name": "hero-2",
+					"sort": "by-name",
+				}},
+			}}},
+		},
+		{
+			"resolve variables in parameter function arguments",
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "hero", With: domain.Params{Values: map[string]interface{}{
+				"signature": domain.NewHMACSHA256(domain.Variable{Target: "payload"}, domain.Variable{Target: "secret"}),
+			}}}}},
+			restql.QueryInput{Params: map[string]interface{}{"payload": "id=1", "secret": "s3cr3t"}},
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "hero", With: domain.Params{Values: map[string]interface{}{
+				"signature": domain.NewHMACSHA256("id=1", "s3cr3t"),
+			}}}}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -282,3 +404,84 @@ func TestResolveVariables(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRequiredVariables(t *testing.T) {
+	query := domain.Query{Statements: []domain.Statement{
+		{
+			Method:   "from",
+			Resource: "hero",
+			Headers:  map[string]interface{}{"Authorization": domain.RequiredVariable{Target: "token"}},
+			With: domain.Params{Values: map[string]interface{}{
+				"id":   domain.RequiredVariable{Target: "id"},
+				"page": domain.DefaultVariable{Target: "page", Default: 1},
+			}},
+		},
+		{
+			Method:   "from",
+			Resource: "sidekick",
+			With: domain.Params{Values: map[string]interface{}{
+				"ids":  domain.NoMultiplex{Value: []interface{}{domain.RequiredVariable{Target: "sidekickId"}}},
+				"hero": domain.RequiredVariable{Target: "id"},
+			}},
+		},
+		{
+			Method:   "to",
+			Resource: "villain",
+			With:     domain.Params{Body: domain.NoMultiplex{Value: domain.RequiredVariable{Target: "villain"}}},
+			When:     &domain.Condition{Left: domain.RequiredVariable{Target: "tier"}, Operator: domain.NotEqualOperator, Right: domain.DefaultVariable{Target: "guest", Default: domain.RequiredVariable{Target: "fallback"}}},
+		},
+		{
+			Method:   "from",
+			Resource: "comics",
+			Headers:  map[string]interface{}{"X-Trace-Id": domain.Template{"trace-", domain.RequiredVariable{Target: "traceId"}}},
+			Only:     []interface{}{domain.NewTake([]string{"issues"}, domain.RequiredVariable{Target: "limit"})},
+		},
+	}}
+
+	t.Run("should return nil when all required variables are present", func(t *testing.T) {
+		input := restql.QueryInput{
+			Params:  map[string]interface{}{"id": "1234", "tier": "vip", "fallback": "guest", "traceId": "t1", "limit": 10},
+			Body:    map[string]interface{}{"sidekickId": "5678", "villain": map[string]interface{}{"name": "joker"}},
+			Headers: map[string]string{"Token": "abc"},
+		}
+
+		err := eval.ValidateRequiredVariables(query, input)
+
+		test.VerifyError(t, err)
+	})
+
+	t.Run("should return validation error naming missing variables", func(t *testing.T) {
+		input := restql.QueryInput{Params: map[string]interface{}{"id": "1234"}}
+
+		err := eval.ValidateRequiredVariables(query, input)
+
+		test.Equal(t, errors.Is(err, eval.ErrValidation), true)
+		test.Equal(t, err.Error(), "validation error: required variables not provided: fallback, limit, sidekickId, tier, token, traceId, villain")
+	})
+
+	signed := domain.Query{Statements: []domain.Statement{{
+		Method:   "from",
+		Resource: "hero",
+		With: domain.Params{Values: map[string]interface{}{
+			"signature": domain.NewHMACSHA256(domain.Variable{Target: "payload"}, domain.Variable{Target: "secret"}),
+		}},
+	}}}
+
+	t.Run("should return validation error when hmac-sha256 secret is missing", func(t *testing.T) {
+		input := restql.QueryInput{Params: map[string]interface{}{"payload": "id=1"}}
+
+		err := eval.ValidateRequiredVariables(signed, input)
+
+		test.Equal(t, errors.Is(err, eval.ErrValidation), true)
+		test.Equal(t, err.Error(), "validation error: required variables not provided: secret")
+	})
+
+	t.Run("should return validation error when hmac-sha256 secret is not a string or number", func(t *testing.T) {
+		input := restql.QueryInput{Body: map[string]interface{}{"payload": "id=1", "secret": []interface{}{"a", "b"}}}
+
+		err := eval.ValidateRequiredVariables(signed, input)
+
+		test.Equal(t, errors.Is(err, eval.ErrValidation), true)
+		test.Equal(t, err.Error(), "validation error: hmac-sha256 secret must be a string or a number, got [a b]")
+	})
+}
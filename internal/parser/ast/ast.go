@@ -9,26 +9,56 @@ const (
 	DeleteMethod        = "delete"
 	WithKeyword         = "with"
 	OnlyKeyword         = "only"
+	ExcludeKeyword      = "exclude"
 	HeadersKeyword      = "headers"
 	HiddenKeyword       = "hidden"
 	TimeoutKeyword      = "timeout"
 	MaxAgeKeyword       = "max-age"
 	SmaxAgeKeyword      = "s-max-age"
 	IgnoreErrorsKeyword = "ignore-errors"
+	WhenKeyword         = "when"
+	PaginateKeyword     = "paginate"
+	RetryKeyword        = "retry"
+	OnErrorKeyword      = "on-error"
+	DependsOnKeyword    = "depends-on"
+	IncludeKeyword      = "include"
+	ParamsKeyword       = "params"
+	MultiplexKeyword    = "multiplex"
+	UnmatchedKeyword    = "unmatched"
+	RequiredKeyword     = "required"
 	Matches             = "matches"
+	SortByFunction      = "sort-by"
+	TakeFunction        = "take"
+	SkipFunction        = "skip"
+	DistinctFunction    = "distinct"
+	IndexByFunction     = "index-by"
+	GroupByFunction     = "group-by"
 	NoMultiplex         = "no-multiplex"
+	Batch               = "batch"
+	Cross               = "cross"
 	Base64              = "base64"
 	JSON                = "json"
 	AsBody              = "as-body"
 	Flatten             = "flatten"
 	NoExplode           = "no-explode"
 	AsQuery             = "as-query"
+	Upper               = "upper"
+	Lower               = "lower"
+	Trim                = "trim"
+	Join                = "join"
+	Split               = "split"
+	ToInt               = "to-int"
+	ToString            = "to-string"
+	URLEncode           = "url-encode"
+	SHA256              = "sha256"
+	HMACSHA256          = "hmac-sha256"
 )
 
 // Query is the root of the restQL AST.
 type Query struct {
-	Use    []Use
-	Blocks []Block
+	Use     []Use
+	Include []Include
+	Blocks  []Block
 }
 
 // Use is the syntax node representing the `use` clause.
@@ -42,6 +72,36 @@ type Use struct {
 type UseValue struct {
 	Int    *int
 	String *string
+	Params []ParamDeclaration
+}
+
+// Types available to declare a parameter on the `use params` clause.
+const (
+	StringParamType  = "string"
+	IntParamType     = "int"
+	FloatParamType   = "float"
+	BooleanParamType = "boolean"
+	ListParamType    = "list"
+	EnumParamType    = "enum"
+)
+
+// ParamDeclaration is the syntax node representing a
+// parameter declared on the `use params` clause.
+// Enum holds the allowed values of the enum type.
+type ParamDeclaration struct {
+	Name     string
+	Type     string
+	Enum     []string
+	Required bool
+	Default  *Value
+}
+
+// Include is the syntax node representing the `include` clause.
+type Include struct {
+	Namespace string
+	Query     string
+	Revision  int
+	Alias     string
 }
 
 // Block is the syntax node representing a statement.
@@ -50,46 +110,104 @@ type Block struct {
 	Resource   string
 	Alias      string
 	In         []string
+	InBy       *AggregationKey
 	Qualifiers []Qualifier
 }
 
+// AggregationKey is the syntax node representing the `by`
+// part of the `in` clause, with the path of the key on the
+// target items and on the statement result items, and the
+// optional `unmatched` mode.
+type AggregationKey struct {
+	Target    []string
+	Origin    []string
+	Unmatched string
+}
+
 // Qualifier is the syntax node representing statement
-// clauses: `with`, `only`, `hidden`, `headers`, `timeout`
-// `max-age`, `s-max-age` and `ignore-errors`.
+// clauses: `with`, `only`, `exclude`, `hidden`, `headers`, `timeout`
+// `max-age`, `s-max-age`, `when`, `paginate`, `retry`,
+// `on-error` and `ignore-errors`.
 type Qualifier struct {
 	With         *Parameters
 	Only         []Filter
+	Exclude      [][]string
 	Headers      []HeaderItem
-	DependsOn    string
+	DependsOn    *DependsOnValue
 	Hidden       bool
 	Timeout      *TimeoutValue
 	MaxAge       *MaxAgeValue
 	SMaxAge      *SMaxAgeValue
+	When         *Condition
+	Paginate     *Pagination
+	Retry        *Retry
+	OnError      *OnError
 	IgnoreErrors bool
 }
 
 // Filter is the syntax node representing entries
 // in the `only` clause.
+// Alias is the name under which the field is returned.
 type Filter struct {
 	Field     []string
 	Functions []interface{}
+	Alias     string
 }
 
 // Match is the syntax node representing the
 // `matches` function.
 type Match struct {
 	String   *string
-	Variable *string
+	Variable *VariableValue
 }
 
 // FilterByRegex is the syntax node representing the
 // `filterByRegex` function.
 type FilterByRegex struct {
 	PathString   *string
-	PathVariable *string
+	PathVariable *VariableValue
 
 	RegexString   *string
-	RegexVariable *string
+	RegexVariable *VariableValue
+}
+
+// SortBy is the syntax node representing the
+// `sort-by` function.
+type SortBy struct {
+	PathString   *string
+	PathVariable *VariableValue
+
+	OrderString   *string
+	OrderVariable *VariableValue
+}
+
+// Take is the syntax node representing the
+// `take` function.
+type Take variableValueOrInt
+
+// Skip is the syntax node representing the
+// `skip` function.
+type Skip variableValueOrInt
+
+// Distinct is the syntax node representing the
+// `distinct` function.
+type Distinct struct {
+	PathString   *string
+	PathVariable *VariableValue
+}
+
+// IndexBy is the syntax node representing the
+// `index-by` function.
+type IndexBy struct {
+	PathString   *string
+	PathVariable *VariableValue
+}
+
+// GroupBy is the syntax node representing the
+// `group-by` function.
+type GroupBy struct {
+	PathString   *string
+	PathVariable *VariableValue
 }
 
 // Parameters is the syntax node representing
@@ -103,7 +221,8 @@ type Parameters struct {
 // the dynamic body feature of the `with` clause.
 type ParameterBody struct {
 	Target    string
-	Functions []string
+	Required  bool
+	Functions []Function
 }
 
 // KeyValue is the syntax node representing
@@ -111,16 +230,26 @@ type ParameterBody struct {
 type KeyValue struct {
 	Key       string
 	Value     Value
-	Functions []string
+	Functions []Function
+}
+
+// Function is the syntax node representing a function
+// applied to a `with` parameter by the `->` operator.
+type Function struct {
+	Name      string
+	Arguments []Value
 }
 
 // Value is the syntax node representing
 // possible types used in the `with` clause
 // parameters.
+// Required and Default are only set for variables.
 type Value struct {
 	List      []Value
 	Object    []ObjectEntry
 	Variable  *string
+	Required  bool
+	Default   *Value
 	Primitive *Primitive
 }
 
@@ -134,12 +263,13 @@ type ObjectEntry struct {
 // Primitive is the syntax node representing
 // the basic restQL value types.
 type Primitive struct {
-	String  *string
-	Int     *int
-	Float   *float64
-	Boolean *bool
-	Chain   []Chained
-	Null    bool
+	String   *string
+	Int      *int
+	Float    *float64
+	Boolean  *bool
+	Chain    []Chained
+	Template []TemplatePart
+	Null     bool
 }
 
 // Chained is the syntax node representing
@@ -160,8 +290,30 @@ type HeaderItem struct {
 // a `headers` clause entry value.
 type HeaderValue struct {
 	Variable *string
+	Required bool
+	Default  *Value
 	String   *string
 	Chain    []Chained
+	Template []TemplatePart
+}
+
+// TemplatePart is the syntax node representing
+// a section of a template string, either a literal
+// text or an interpolated variable or chain.
+type TemplatePart struct {
+	Text     *string
+	Variable *VariableValue
+	Chain    []Chained
+}
+
+// VariableValue is the syntax node representing a
+// variable given as a function argument or template
+// expression, which can be marked as required or
+// have a default value.
+type VariableValue struct {
+	Name     string
+	Required bool
+	Default  *Value
 }
 
 type variableOrInt struct {
@@ -169,6 +321,11 @@ type variableOrInt struct {
 	Int      *int
 }
 
+type variableValueOrInt struct {
+	Variable *VariableValue
+	Int      *int
+}
+
 // TimeoutValue is the syntax node representing
 // the value in the `timeout` clause.
 type TimeoutValue variableOrInt
@@ -182,8 +339,51 @@ type MaxAgeValue variableOrInt
 type SMaxAgeValue variableOrInt
 
 // DependsOnValue is the syntax node representing
-// the value in the `depends-on` clause.
-type DependsOnValue string
+// the value in the `depends-on` clause. Condition is
+// empty when no `on success` or `on failure` is given.
+type DependsOnValue struct {
+	Targets   []string
+	Condition string
+}
+
+// Condition is the syntax node representing
+// the `when` clause. When no operator is present
+// only the left operand is evaluated.
+type Condition struct {
+	Left     Value
+	Operator string
+	Right    *Value
+}
+
+// Pagination is the syntax node representing
+// the `paginate` clause.
+type Pagination struct {
+	Param    string
+	Path     []string
+	MaxPages *MaxPagesValue
+}
+
+// MaxPagesValue is the syntax node representing
+// the page limit in the `paginate` clause.
+type MaxPagesValue variableOrInt
+
+// Retry is the syntax node representing
+// the `retry` clause.
+type Retry struct {
+	Attempts RetryValue
+	Backoff  *RetryValue
+	Unsafe   bool
+}
+
+// RetryValue is the syntax node representing
+// the values in the `retry` clause.
+type RetryValue variableOrInt
+
+// OnError is the syntax node representing
+// the `on-error` clause.
+type OnError struct {
+	Default Value
+}
 
 // Generator encapsulate the parsing implementation
 // used to transform a query string into an AST.
@@ -200,7 +400,7 @@ const noFilename = ""
 func (g Generator) Parse(query string) (*Query, error) {
 	parse, err := Parse(noFilename, []byte(query))
 	if err != nil {
-		return nil, err
+		return nil, newParseError([]byte(query), err)
 	}
 
 	q := parse.(Query)
@@ -1,6 +1,7 @@
 package ast_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/b2wdigital/restQL-golang/v6/internal/parser/ast"
@@ -11,6 +12,10 @@ func String(s string) *string {
 	return &s
 }
 
+func Var(name string) *ast.VariableValue {
+	return &ast.VariableValue{Name: name}
+}
+
 func Int(i int) *int {
 	return &i
 }
@@ -58,6 +63,30 @@ func TestAstGenerator(t *testing.T) {
 				Blocks: []ast.Block{{Method: ast.FromMethod, Resource: "cart"}},
 			},
 		},
+		{
+			"Simple from resource query with params declaration",
+			`
+							use params {
+								id: int required,
+								page: int = 1,
+								sort: enum("asc", "desc"),
+								tags: list
+							}
+
+							from cart
+					`,
+			ast.Query{
+				Use: []ast.Use{
+					{Key: ast.ParamsKeyword, Value: ast.UseValue{Params: []ast.ParamDeclaration{
+						{Name: "id", Type: ast.IntParamType, Required: true},
+						{Name: "page", Type: ast.IntParamType, Default: &ast.Value{Primitive: &ast.Primitive{Int: Int(1)}}},
+						{Name: "sort", Type: ast.EnumParamType, Enum: []string{"asc", "desc"}},
+						{Name: "tags", Type: ast.ListParamType},
+					}}},
+				},
+				Blocks: []ast.Block{{Method: ast.FromMethod, Resource: "cart"}},
+			},
+		},
 		{
 			"query with two from statements",
 			`
@@ -328,7 +357,7 @@ func TestAstGenerator(t *testing.T) {
 										{Primitive: &ast.Primitive{String: String("sword")}},
 										{Primitive: &ast.Primitive{String: String("shield")}},
 									}},
-									Functions: []string{"no-multiplex"},
+									Functions: []ast.Function{{Name: "no-multiplex"}},
 								},
 							},
 						},
@@ -348,7 +377,7 @@ func TestAstGenerator(t *testing.T) {
 							{
 								Key:       "id",
 								Value:     ast.Value{Primitive: &ast.Primitive{Chain: []ast.Chained{{PathItem: "done-resource"}, {PathItem: "id"}}}},
-								Functions: []string{"no-multiplex"},
+								Functions: []ast.Function{{Name: "no-multiplex"}},
 							},
 						},
 					},
@@ -367,7 +396,7 @@ func TestAstGenerator(t *testing.T) {
 							{
 								Key:       "id",
 								Value:     ast.Value{Primitive: &ast.Primitive{String: String("abcdefg12345")}},
-								Functions: []string{"base64"},
+								Functions: []ast.Function{{Name: "base64"}},
 							},
 						},
 					},
@@ -386,7 +415,7 @@ func TestAstGenerator(t *testing.T) {
 							{
 								Key:       "id",
 								Value:     ast.Value{List: []ast.Value{{Object: []ast.ObjectEntry{{Key: "registryNumber", Value: ast.Value{Primitive: &ast.Primitive{String: String("abcdefg12345")}}}}}}},
-								Functions: []string{"as-body"},
+								Functions: []ast.Function{{Name: "as-body"}},
 							},
 						},
 					},
@@ -404,7 +433,7 @@ func TestAstGenerator(t *testing.T) {
 						KeyValues: []ast.KeyValue{{
 							Key:       "id",
 							Value:     ast.Value{Object: []ast.ObjectEntry{{Key: "id", Value: ast.Value{Primitive: &ast.Primitive{String: String("1")}}}}},
-							Functions: []string{"json"},
+							Functions: []ast.Function{{Name: "json"}},
 						}},
 					},
 				}},
@@ -421,7 +450,7 @@ func TestAstGenerator(t *testing.T) {
 						KeyValues: []ast.KeyValue{{
 							Key:       "id",
 							Value:     ast.Value{List: []ast.Value{{Primitive: &ast.Primitive{Int: Int(1)}}, {Primitive: &ast.Primitive{Int: Int(2)}}, {Primitive: &ast.Primitive{Int: Int(3)}}}},
-							Functions: []string{"no-multiplex", "json"},
+							Functions: []ast.Function{{Name: "no-multiplex"}, {Name: "json"}},
 						}},
 					},
 				}},
@@ -442,7 +471,7 @@ func TestAstGenerator(t *testing.T) {
 								{List: []ast.Value{{Primitive: &ast.Primitive{Int: Int(2)}}}},
 								{List: []ast.Value{{Primitive: &ast.Primitive{Int: Int(3)}}}},
 							}},
-							Functions: []string{"flatten"},
+							Functions: []ast.Function{{Name: "flatten"}},
 						}},
 					},
 				}},
@@ -511,7 +540,7 @@ func TestAstGenerator(t *testing.T) {
 								With: &ast.Parameters{
 									Body: &ast.ParameterBody{
 										Target:    "body",
-										Functions: []string{"no-multiplex"},
+										Functions: []ast.Function{{Name: "no-multiplex"}},
 									},
 								},
 							},
@@ -579,7 +608,7 @@ func TestAstGenerator(t *testing.T) {
 				Resource: "hero",
 				Qualifiers: []ast.Qualifier{
 					{Only: []ast.Filter{
-						{Field: []string{"name"}, Functions: []interface{}{ast.Match{Variable: String("heroName")}}},
+						{Field: []string{"name"}, Functions: []interface{}{ast.Match{Variable: Var("heroName")}}},
 						{Field: []string{"weapons"}},
 					}},
 				},
@@ -834,7 +863,7 @@ func TestAstGenerator(t *testing.T) {
 											}},
 										},
 									}},
-									Functions: []string{"no-explode"},
+									Functions: []ast.Function{{Name: "no-explode"}},
 								},
 							},
 						},
@@ -873,7 +902,7 @@ func TestAstGenerator(t *testing.T) {
 					Resource: "sku",
 					Qualifiers: []ast.Qualifier{
 						{
-							DependsOn: "cart",
+							DependsOn: &ast.DependsOnValue{Targets: []string{"cart"}},
 						},
 						{
 							With: &ast.Parameters{
@@ -914,7 +943,7 @@ func TestAstGenerator(t *testing.T) {
 				Resource: "hero",
 				Qualifiers: []ast.Qualifier{
 					{Only: []ast.Filter{
-						{Field: []string{"name"}, Functions: []interface{}{ast.FilterByRegex{PathVariable: String("nameField"), RegexString: String("^Super")}}},
+						{Field: []string{"name"}, Functions: []interface{}{ast.FilterByRegex{PathVariable: Var("nameField"), RegexString: String("^Super")}}},
 						{Field: []string{"weapons"}},
 					}},
 				},
@@ -931,7 +960,7 @@ func TestAstGenerator(t *testing.T) {
 				Resource: "hero",
 				Qualifiers: []ast.Qualifier{
 					{Only: []ast.Filter{
-						{Field: []string{"name"}, Functions: []interface{}{ast.FilterByRegex{PathString: String("profile.name"), RegexVariable: String("heroNamePattern")}}},
+						{Field: []string{"name"}, Functions: []interface{}{ast.FilterByRegex{PathString: String("profile.name"), RegexVariable: Var("heroNamePattern")}}},
 						{Field: []string{"weapons"}},
 					}},
 				},
@@ -948,7 +977,7 @@ func TestAstGenerator(t *testing.T) {
 				Resource: "hero",
 				Qualifiers: []ast.Qualifier{
 					{Only: []ast.Filter{
-						{Field: []string{"name"}, Functions: []interface{}{ast.FilterByRegex{PathVariable: String("nameField"), RegexVariable: String("heroNamePattern")}}},
+						{Field: []string{"name"}, Functions: []interface{}{ast.FilterByRegex{PathVariable: Var("nameField"), RegexVariable: Var("heroNamePattern")}}},
 						{Field: []string{"weapons"}},
 					}},
 				},
@@ -970,13 +999,318 @@ func TestAstGenerator(t *testing.T) {
 							{
 								Key:       "context",
 								Value:     ast.Value{Primitive: &ast.Primitive{String: String("crossover")}},
-								Functions: []string{"as-query"},
+								Functions: []ast.Function{{Name: "as-query"}},
 							},
 						},
 					},
 				}},
 			}}},
 		},
+		{
+			"Get query with when clause checking variable existence",
+			`from loyalty when $customerId with id = $customerId`,
+			ast.Query{Blocks: []ast.Block{{
+				Method:   ast.FromMethod,
+				Resource: "loyalty",
+				Qualifiers: []ast.Qualifier{
+					{When: &ast.Condition{Left: ast.Value{Variable: String("customerId")}}},
+					{With: &ast.Parameters{KeyValues: []ast.KeyValue{{Key: "id", Value: ast.Value{Variable: String("customerId")}}}}},
+				},
+			}}},
+		},
+		{
+			"Get query with when clause comparing chained value",
+			`from loyalty
+				when customer.type != "guest"
+				with id = customer.id`,
+			ast.Query{Blocks: []ast.Block{{
+				Method:   ast.FromMethod,
+				Resource: "loyalty",
+				Qualifiers: []ast.Qualifier{
+					{When: &ast.Condition{
+						Left:     ast.Value{Primitive: &ast.Primitive{Chain: []ast.Chained{{PathItem: "customer"}, {PathItem: "type"}}}},
+						Operator: "!=",
+						Right:    &ast.Value{Primitive: &ast.Primitive{String: String("guest")}},
+					}},
+					{With: &ast.Parameters{KeyValues: []ast.KeyValue{{Key: "id", Value: ast.Value{Primitive: &ast.Primitive{Chain: []ast.Chained{{PathItem: "customer"}, {PathItem: "id"}}}}}}}},
+				},
+			}}},
+		},
+		{
+			"Get query with when clause comparing variable to boolean",
+			`from loyalty when $logged = true`,
+			ast.Query{Blocks: []ast.Block{{
+				Method:   ast.FromMethod,
+				Resource: "loyalty",
+				Qualifiers: []ast.Qualifier{
+					{When: &ast.Condition{
+						Left:     ast.Value{Variable: String("logged")},
+						Operator: "=",
+						Right:    &ast.Value{Primitive: &ast.Primitive{Boolean: Boolean(true)}},
+					}},
+				},
+			}}},
+		},
+		{
+			"Query with include clauses",
+			`
+							use timeout 8000
+							include common/checkout/3 as ck
+							include common/customer/1
+
+							from loyalty with id = ck:customer.id
+					`,
+			ast.Query{
+				Use: []ast.Use{{Key: ast.TimeoutKeyword, Value: ast.UseValue{Int: Int(8000)}}},
+				Include: []ast.Include{
+					{Namespace: "common", Query: "checkout", Revision: 3, Alias: "ck"},
+					{Namespace: "common", Query: "customer", Revision: 1},
+				},
+				Blocks: []ast.Block{{
+					Method:   ast.FromMethod,
+					Resource: "loyalty",
+					Qualifiers: []ast.Qualifier{
+						{With: &ast.Parameters{KeyValues: []ast.KeyValue{{Key: "id", Value: ast.Value{Primitive: &ast.Primitive{Chain: []ast.Chained{{PathItem: "ck:customer"}, {PathItem: "id"}}}}}}}},
+					},
+				}},
+			},
+		},
+		{
+			"Query with only include clause",
+			`include common/checkout/3`,
+			ast.Query{Include: []ast.Include{{Namespace: "common", Query: "checkout", Revision: 3}}},
+		},
+		{
+			"Query with required and default variables",
+			`from hero
+				headers Authorization = $token!, X-Trace-Id = $traceId ?: "none"
+				with id = $id!, page = $page ?: 1, size = $size ?: $defaultSize`,
+			ast.Query{Blocks: []ast.Block{{
+				Method:   ast.FromMethod,
+				Resource: "hero",
+				Qualifiers: []ast.Qualifier{
+					{Headers: []ast.HeaderItem{
+						{Key: "Authorization", Value: ast.HeaderValue{Variable: String("token"), Required: true}},
+						{Key: "X-Trace-Id", Value: ast.HeaderValue{Variable: String("traceId"), Default: &ast.Value{Primitive: &ast.Primitive{String: String("none")}}}},
+					}},
+					{With: &ast.Parameters{KeyValues: []ast.KeyValue{
+						{Key: "id", Value: ast.Value{Variable: String("id"), Required: true}},
+						{Key: "page", Value: ast.Value{Variable: String("page"), Default: &ast.Value{Primitive: &ast.Primitive{Int: Int(1)}}}},
+						{Key: "size", Value: ast.Value{Variable: String("size"), Default: &ast.Value{Variable: String("defaultSize")}}},
+					}}},
+				},
+			}}},
+		},
+		{
+			"Get query with select filters renaming fields",
+			`from hero
+								only
+										name as heroName
+										city.name as cityName
+										nickname -> matches("^Dark") as heroNickname
+										*
+								ignore-errors`,
+			ast.Query{Blocks: []ast.Block{{
+				Method:   ast.FromMethod,
+				Resource: "hero",
+				Qualifiers: []ast.Qualifier{
+					{Only: []ast.Filter{
+						{Field: []string{"name"}, Alias: "heroName"},
+						{Field: []string{"city", "name"}, Alias: "cityName"},
+						{Field: []string{"nickname"}, Functions: []interface{}{ast.Match{String: String("^Dark")}}, Alias: "heroNickname"},
+						{Field: []string{"*"}},
+					}},
+					{IgnoreErrors: true},
+				},
+			}}},
+		},
+		{
+			"Get query with select filters and list functions",
+			`from product
+								only
+										offers -> sort-by("price", $order) -> skip($offset) -> take(10)
+										sellers -> distinct("id") -> sort-by($sortField)
+										tags -> distinct()`,
+			ast.Query{Blocks: []ast.Block{{
+				Method:   ast.FromMethod,
+				Resource: "product",
+				Qualifiers: []ast.Qualifier{
+					{Only: []ast.Filter{
+						{Field: []string{"offers"}, Functions: []interface{}{
+							ast.SortBy{PathString: String("price"), OrderVariable: Var("order")},
+							ast.Skip{Variable: Var("offset")},
+							ast.Take{Int: Int(10)},
+						}},
+						{Field: []string{"sellers"}, Functions: []interface{}{
+							ast.Distinct{PathString: String("id")},
+							ast.SortBy{PathVariable: Var("sortField")},
+						}},
+						{Field: []string{"tags"}, Functions: []interface{}{ast.Distinct{}}},
+					}},
+				},
+			}}},
+		},
+		{
+			"Query with paginate clause",
+			`from product
+				paginate cursor = result.nextCursor max $pages
+				with category = "books"
+
+			from seller
+				paginate page = next`,
+			ast.Query{Blocks: []ast.Block{
+				{
+					Method:   ast.FromMethod,
+					Resource: "product",
+					Qualifiers: []ast.Qualifier{
+						{Paginate: &ast.Pagination{Param: "cursor", Path: []string{"result", "nextCursor"}, MaxPages: &ast.MaxPagesValue{Variable: String("pages")}}},
+						{With: &ast.Parameters{KeyValues: []ast.KeyValue{{Key: "category", Value: ast.Value{Primitive: &ast.Primitive{String: String("books")}}}}}},
+					},
+				},
+				{
+					Method:     ast.FromMethod,
+					Resource:   "seller",
+					Qualifiers: []ast.Qualifier{{Paginate: &ast.Pagination{Param: "page", Path: []string{"next"}}}},
+				},
+			}},
+		},
+		{
+			"Query with retry clause",
+			`from hero
+				retry 2 backoff $backoff
+
+			from sidekick
+				retry $attempts
+
+			to villain
+				retry 1 unsafe`,
+			ast.Query{Blocks: []ast.Block{
+				{
+					Method:     ast.FromMethod,
+					Resource:   "hero",
+					Qualifiers: []ast.Qualifier{{Retry: &ast.Retry{Attempts: ast.RetryValue{Int: Int(2)}, Backoff: &ast.RetryValue{Variable: String("backoff")}}}},
+				},
+				{
+					Method:     ast.FromMethod,
+					Resource:   "sidekick",
+					Qualifiers: []ast.Qualifier{{Retry: &ast.Retry{Attempts: ast.RetryValue{Variable: String("attempts")}}}},
+				},
+				{
+					Method:     ast.ToMethod,
+					Resource:   "villain",
+					Qualifiers: []ast.Qualifier{{Retry: &ast.Retry{Attempts: ast.RetryValue{Int: Int(1)}, Unsafe: true}}},
+				},
+			}},
+		},
+		{
+			"Query with on-error clause",
+			`from hero
+				on-error default {items: []}
+
+			from sidekick
+				on-error default $fallback`,
+			ast.Query{Blocks: []ast.Block{
+				{
+					Method:     ast.FromMethod,
+					Resource:   "hero",
+					Qualifiers: []ast.Qualifier{{OnError: &ast.OnError{Default: ast.Value{Object: []ast.ObjectEntry{{Key: "items", Value: ast.Value{List: []ast.Value{}}}}}}}},
+				},
+				{
+					Method:     ast.FromMethod,
+					Resource:   "sidekick",
+					Qualifiers: []ast.Qualifier{{OnError: &ast.OnError{Default: ast.Value{Variable: String("fallback")}}}},
+				},
+			}},
+		},
+		{
+			"Query with template strings",
+			`from hero
+				headers
+					Authorization = "Bearer ${$token}"
+				with
+					id = "${done-resource.id}-${ $suffix }"`,
+			ast.Query{Blocks: []ast.Block{{Method: ast.FromMethod, Resource: "hero", Qualifiers: []ast.Qualifier{
+				{Headers: []ast.HeaderItem{{Key: "Authorization", Value: ast.HeaderValue{Template: []ast.TemplatePart{{Text: String("Bearer ")}, {Variable: Var("token")}}}}}},
+				{With: &ast.Parameters{KeyValues: []ast.KeyValue{{Key: "id", Value: ast.Value{Primitive: &ast.Primitive{Template: []ast.TemplatePart{
+					{Chain: []ast.Chained{{PathItem: "done-resource"}, {PathItem: "id"}}},
+					{Text: String("-")},
+					{Variable: Var("suffix")},
+				}}}}}}},
+			}}}},
+		},
+		{
+			"Query with parameter functions with arguments",
+			`from hero
+				with
+					ids = $ids -> join(";") -> upper
+					signature = $payload -> hmac-sha256($secret)
+					tags = "a,b" -> split() -> trim`,
+			ast.Query{Blocks: []ast.Block{{Method: ast.FromMethod, Resource: "hero", Qualifiers: []ast.Qualifier{
+				{With: &ast.Parameters{KeyValues: []ast.KeyValue{
+					{Key: "ids", Value: ast.Value{Variable: String("ids")}, Functions: []ast.Function{
+						{Name: "join", Arguments: []ast.Value{{Primitive: &ast.Primitive{String: String(";")}}}},
+						{Name: "upper"},
+					}},
+					{Key: "signature", Value: ast.Value{Variable: String("payload")}, Functions: []ast.Function{
+						{Name: "hmac-sha256", Arguments: []ast.Value{{Variable: String("secret")}}},
+					}},
+					{Key: "tags", Value: ast.Value{Primitive: &ast.Primitive{String: String("a,b")}}, Functions: []ast.Function{
+						{Name: "split"},
+						{Name: "trim"},
+					}},
+				}}},
+			}}}},
+		},
+		{
+			"Query with plugin functions on parameters and filters",
+			`from product with document = $doc -> mask("*", 4) only price -> to_currency($locale)`,
+			ast.Query{Blocks: []ast.Block{{
+				Method:   ast.FromMethod,
+				Resource: "product",
+				Qualifiers: []ast.Qualifier{
+					{With: &ast.Parameters{KeyValues: []ast.KeyValue{
+						{Key: "document", Value: ast.Value{Variable: String("doc")}, Functions: []ast.Function{
+							{Name: "mask", Arguments: []ast.Value{{Primitive: &ast.Primitive{String: String("*")}}, {Primitive: &ast.Primitive{Int: Int(4)}}}},
+						}},
+					}}},
+					{Only: []ast.Filter{
+						{Field: []string{"price"}, Functions: []interface{}{
+							ast.Function{Name: "to_currency", Arguments: []ast.Value{{Variable: String("locale")}}},
+						}},
+					}},
+				},
+			}}},
+		},
+		{
+			"Query with index-by and group-by functions",
+			`from product only skus -> index-by("id"), offers -> group-by($groupField)`,
+			ast.Query{Blocks: []ast.Block{{
+				Method:   ast.FromMethod,
+				Resource: "product",
+				Qualifiers: []ast.Qualifier{
+					{Only: []ast.Filter{
+						{Field: []string{"skus"}, Functions: []interface{}{ast.IndexBy{PathString: String("id")}}},
+						{Field: []string{"offers"}, Functions: []interface{}{ast.GroupBy{PathVariable: Var("groupField")}}},
+					}},
+				},
+			}}},
+		},
+		{
+			"Query with exclude clause",
+			`from product
+				exclude
+					description
+					offers.html
+				ignore-errors`,
+			ast.Query{Blocks: []ast.Block{{
+				Method:   ast.FromMethod,
+				Resource: "product",
+				Qualifiers: []ast.Qualifier{
+					{Exclude: [][]string{{"description"}, {"offers", "html"}}},
+					{IgnoreErrors: true},
+				},
+			}}},
+		},
 	}
 
 	generator, err := ast.New()
@@ -992,3 +1326,256 @@ func TestAstGenerator(t *testing.T) {
 		})
 	}
 }
+
+func TestAstGeneratorFunctionArity(t *testing.T) {
+	generator, err := ast.New()
+	test.VerifyError(t, err)
+
+	tests := []string{
+		`from hero with signature = $payload -> hmac-sha256`,
+		`from hero with ids = $ids -> join(",", ";")`,
+		`from hero with name = $name -> upper("x")`,
+	}
+
+	for _, query := range tests {
+		t.Run(query, func(t *testing.T) {
+			_, err := generator.Parse(query)
+			if err == nil {
+				t.Errorf("expected an error parsing %s", query)
+			}
+		})
+	}
+}
+
+func TestAstGeneratorExclusiveFilterClauses(t *testing.T) {
+	generator, err := ast.New()
+	test.VerifyError(t, err)
+
+	tests := []string{
+		`from product only id exclude description`,
+		`from product exclude description only id`,
+		`from product exclude description hidden`,
+	}
+
+	for _, query := range tests {
+		t.Run(query, func(t *testing.T) {
+			_, err := generator.Parse(query)
+			if err == nil {
+				t.Errorf("expected an error parsing %s", query)
+			}
+		})
+	}
+}
+
+func TestAstGeneratorInvalidParamsDeclaration(t *testing.T) {
+	generator, err := ast.New()
+	test.VerifyError(t, err)
+
+	tests := []string{
+		`use params {id: int, id: string} from hero`,
+		`use params {id: int required = 1} from hero`,
+		`use params {id: integer} from hero`,
+		`use params {sort: enum()} from hero`,
+	}
+
+	for _, query := range tests {
+		t.Run(query, func(t *testing.T) {
+			_, err := generator.Parse(query)
+			if err == nil {
+				t.Errorf("expected an error parsing %s", query)
+			}
+		})
+	}
+}
+
+func TestAstGeneratorParseErrors(t *testing.T) {
+	generator, err := ast.New()
+	test.VerifyError(t, err)
+
+	tests := []struct {
+		name     string
+		query    string
+		expected ast.ParseError
+	}{
+		{
+			"misspelled method",
+			`form hero`,
+			ast.ParseError{
+				Line:       1,
+				Column:     1,
+				Token:      "form",
+				Expected:   []string{"delete", "from", "include", "into", "to", "update", "use"},
+				Suggestion: `did you mean "from"?`,
+				Message:    `unexpected "form"`,
+			},
+		},
+		{
+			"misspelled clause",
+			`from hero wiht id = 1`,
+			ast.ParseError{
+				Line:       1,
+				Column:     11,
+				Token:      "wiht",
+				Expected:   []string{"as", "depends-on", "exclude", "headers", "hidden", "ignore-errors", "in", "max-age", "on-error", "only", "paginate", "retry", "s-max-age", "timeout", "when", "with"},
+				Suggestion: `did you mean "with"?`,
+				Message:    `unexpected "wiht"`,
+			},
+		},
+		{
+			"clause in wrong order",
+			`from hero only name with id = 1`,
+			ast.ParseError{
+				Line:       1,
+				Column:     21,
+				Token:      "with",
+				Expected:   []string{",", "->", "as", "ignore-errors"},
+				Suggestion: "the with clause is out of order, clauses must be declared as headers, timeout, max-age, s-max-age, depends-on, when, paginate, retry and on-error, then with, then only, exclude or hidden, then ignore-errors",
+				Message:    `unexpected "with"`,
+			},
+		},
+		{
+			"clause in wrong order after filter list",
+			"from hero\n\twith id = 1\n\tonly name\n\ttimeout 100",
+			ast.ParseError{
+				Line:       4,
+				Column:     10,
+				Token:      "100",
+				Expected:   []string{",", "->", "as", "ignore-errors"},
+				Suggestion: "the timeout clause is out of order, clauses must be declared as headers, timeout, max-age, s-max-age, depends-on, when, paginate, retry and on-error, then with, then only, exclude or hidden, then ignore-errors",
+				Message:    `unexpected "100"`,
+			},
+		},
+		{
+			"only and exclude on the same statement",
+			`from product only id exclude description`,
+			ast.ParseError{
+				Line:       1,
+				Column:     22,
+				Token:      "exclude",
+				Expected:   []string{",", "->", "as", "ignore-errors"},
+				Suggestion: "the only, exclude and hidden clauses are mutually exclusive, a statement can declare just one of them",
+				Message:    `unexpected "exclude"`,
+			},
+		},
+		{
+			"only after exclude list",
+			"from product\n\texclude\n\t\tid\n\tonly name",
+			ast.ParseError{
+				Line:       4,
+				Column:     7,
+				Token:      "name",
+				Expected:   []string{",", "ignore-errors"},
+				Suggestion: "the only, exclude and hidden clauses are mutually exclusive, a statement can declare just one of them",
+				Message:    `unexpected "name"`,
+			},
+		},
+		{
+			"invalid function arguments",
+			`from hero with id = $id -> upper("a")`,
+			ast.ParseError{
+				Line:    1,
+				Column:  28,
+				Token:   `upper("a")`,
+				Message: "function upper expects between 0 and 0 arguments, got 1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := generator.Parse(tt.query)
+
+			var got ast.ParseError
+			if !errors.As(err, &got) {
+				t.Fatalf("expected a parse error, got %v", err)
+			}
+
+			test.Equal(t, got, tt.expected)
+		})
+	}
+}
+
+func TestPrint(t *testing.T) {
+	generator, err := ast.New()
+	test.VerifyError(t, err)
+
+	tests := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{
+			"single statement",
+			`from hero`,
+			"from hero\n",
+		},
+		{
+			"statement with alias, in and multiple clauses",
+			`from sidekick as robin in hero.sidekick headers X-Id = "1", Authorization = "Bearer ${$token}" timeout $t with id = hero.$field -> flatten, name = "a\tb" only name -> matches("^b"), age ignore-errors`,
+			`from sidekick as robin in hero.sidekick
+    headers
+        X-Id = "1"
+        Authorization = "Bearer ${$token}"
+    timeout $t
+    with
+        id = hero.$field -> flatten
+        name = "a\tb"
+    only
+        name -> matches("^b")
+        age
+    ignore-errors
+`,
+		},
+		{
+			"clauses declared in any order are kept in canonical order",
+			`use timeout 100
+use max-age 600
+include common/checkout/3 as ck
+
+from hero retry 2 backoff 50 when $logged = true with page = $page ?: 1, filter = {active: true, "min score": 1.5} hidden
+
+from villain on-error default [] exclude weapons.damage`,
+			`use timeout 100
+use max-age 600
+include common/checkout/3 as ck
+
+from hero
+    when $logged = true
+    retry 2 backoff 50
+    with
+        page = $page ?: 1
+        filter = {active: true, "min score": 1.5}
+    hidden
+
+from villain
+    on-error default []
+    exclude
+        weapons.damage
+`,
+		},
+		{
+			"required and default variables in templates and only functions",
+			`from hero headers Authorization = "Bearer ${$token!}" only offers -> sort-by($field ?: "price") -> take($size!)`,
+			`from hero
+    headers
+        Authorization = "Bearer ${$token!}"
+    only
+        offers -> sort-by($field ?: "price") -> take($size!)
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := generator.Parse(tt.query)
+			test.VerifyError(t, err)
+
+			got := ast.Print(query)
+			test.Equal(t, got, tt.expected)
+
+			reparsed, err := generator.Parse(got)
+			test.VerifyError(t, err)
+			test.Equal(t, ast.Print(reparsed), got)
+		})
+	}
+}
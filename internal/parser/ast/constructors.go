@@ -7,7 +7,7 @@ import (
 	"strings"
 )
 
-func newQuery(uses, firstBlock, otherBlocks interface{}) (Query, error) {
+func newQuery(uses, includes, firstBlock, otherBlocks interface{}) (Query, error) {
 	var q Query
 
 	useList := uses.([]interface{})
@@ -20,8 +20,20 @@ func newQuery(uses, firstBlock, otherBlocks interface{}) (Query, error) {
 		q.Use = us
 	}
 
-	fb := firstBlock.(Block)
-	blocks := []interface{}{fb}
+	includeList := includes.([]interface{})
+	if len(includeList) > 0 {
+		is := make([]Include, len(includeList))
+		for i, inc := range includeList {
+			is[i] = inc.(Include)
+		}
+
+		q.Include = is
+	}
+
+	var blocks []interface{}
+	if firstBlock != nil {
+		blocks = append(blocks, firstBlock)
+	}
 
 	if otherBlocks != nil {
 		otherBs := otherBlocks.([]interface{})
@@ -32,6 +44,10 @@ func newQuery(uses, firstBlock, otherBlocks interface{}) (Query, error) {
 
 	q.Blocks = newBlockList(blocks)
 
+	if len(q.Blocks) == 0 && len(q.Include) == 0 {
+		return Query{}, errors.New("query must have at least one statement or include")
+	}
+
 	return q, nil
 }
 
@@ -60,6 +76,12 @@ func newUse(rule, value interface{}) (Use, error) {
 	return Use{Key: r, Value: v}, nil
 }
 
+func newUseMultiplex(mode interface{}) (Use, error) {
+	m := mode.(string)
+
+	return Use{Key: MultiplexKeyword, Value: UseValue{String: &m}}, nil
+}
+
 func newUseValue(value interface{}) (UseValue, error) {
 	vInt, ok := value.(int)
 	if ok {
@@ -74,6 +96,88 @@ func newUseValue(value interface{}) (UseValue, error) {
 	return UseValue{}, errors.Errorf("unknown use value type : %T", value)
 }
 
+type paramType struct {
+	Name string
+	Enum []string
+}
+
+func newUseParams(first, others interface{}) (Use, error) {
+	params := []ParamDeclaration{first.(ParamDeclaration)}
+	names := map[string]bool{params[0].Name: true}
+
+	if others != nil {
+		for _, o := range flatten(others.([]interface{})) {
+			p, ok := o.(ParamDeclaration)
+			if !ok {
+				continue
+			}
+
+			if names[p.Name] {
+				return Use{}, fmt.Errorf("param %s is declared more than once", p.Name)
+			}
+			names[p.Name] = true
+
+			params = append(params, p)
+		}
+	}
+
+	return Use{Key: ParamsKeyword, Value: UseValue{Params: params}}, nil
+}
+
+func newParamDeclaration(name, pType, required, defaultValue interface{}) (ParamDeclaration, error) {
+	pt := pType.(paramType)
+	param := ParamDeclaration{
+		Name:     name.(string),
+		Type:     pt.Name,
+		Enum:     pt.Enum,
+		Required: required != nil,
+	}
+
+	if defaultValue != nil {
+		d := defaultValue.([]interface{})
+		v := d[len(d)-1].(Value)
+		param.Default = &v
+	}
+
+	if param.Required && param.Default != nil {
+		return ParamDeclaration{}, fmt.Errorf("param %s can not be required and have a default value", param.Name)
+	}
+
+	return param, nil
+}
+
+func newParamType(text []byte) (paramType, error) {
+	return paramType{Name: string(text)}, nil
+}
+
+func newParamEnum(first, others interface{}) (paramType, error) {
+	values := []string{first.(string)}
+
+	if others != nil {
+		for _, o := range flatten(others.([]interface{})) {
+			if v, ok := o.(string); ok {
+				values = append(values, v)
+			}
+		}
+	}
+
+	return paramType{Name: EnumParamType, Enum: values}, nil
+}
+
+func newInclude(namespace, query, revision, alias interface{}) (Include, error) {
+	inc := Include{
+		Namespace: namespace.(string),
+		Query:     query.(string),
+		Revision:  revision.(int),
+	}
+
+	if alias != nil {
+		inc.Alias = alias.(string)
+	}
+
+	return inc, nil
+}
+
 func newBlock(action, modifiers, with, filter, ignore interface{}) (Block, error) {
 	ac := action.(actionRule)
 	block := Block{
@@ -81,6 +185,7 @@ func newBlock(action, modifiers, with, filter, ignore interface{}) (Block, error
 		Resource: ac.Resource,
 		Alias:    ac.Alias,
 		In:       ac.In,
+		InBy:     ac.InBy,
 	}
 
 	if modifiers != nil {
@@ -98,8 +203,16 @@ func newBlock(action, modifiers, with, filter, ignore interface{}) (Block, error
 				q = Qualifier{MaxAge: m}
 			case *SMaxAgeValue:
 				q = Qualifier{SMaxAge: m}
-			case DependsOnValue:
-				q = Qualifier{DependsOn: string(m)}
+			case *DependsOnValue:
+				q = Qualifier{DependsOn: m}
+			case *Condition:
+				q = Qualifier{When: m}
+			case *Pagination:
+				q = Qualifier{Paginate: m}
+			case *Retry:
+				q = Qualifier{Retry: m}
+			case *OnError:
+				q = Qualifier{OnError: m}
 			default:
 				continue
 			}
@@ -121,6 +234,8 @@ func newBlock(action, modifiers, with, filter, ignore interface{}) (Block, error
 		switch filter := filter.(type) {
 		case []Filter:
 			q = Qualifier{Only: filter}
+		case excludePaths:
+			q = Qualifier{Exclude: filter}
 		case hidden:
 			q = Qualifier{Hidden: true}
 		default:
@@ -145,6 +260,7 @@ type actionRule struct {
 	Resource string
 	Alias    string
 	In       []string
+	InBy     *AggregationKey
 }
 
 func newActionRule(method, resource, alias, in interface{}) (actionRule, error) {
@@ -159,17 +275,43 @@ func newActionRule(method, resource, alias, in interface{}) (actionRule, error)
 	}
 
 	if in != nil {
-		i := in.([]string)
-		ar.In = i
+		i := in.(inClause)
+		ar.In = i.Path
+		ar.InBy = i.By
 	}
 
 	return ar, nil
 }
 
-func newIn(target interface{}) ([]string, error) {
+type inClause struct {
+	Path []string
+	By   *AggregationKey
+}
+
+func newIn(target, by interface{}) (inClause, error) {
 	t := target.(string)
-	path := strings.Split(t, ".")
-	return path, nil
+	in := inClause{Path: strings.Split(t, ".")}
+
+	if by != nil {
+		key := by.(AggregationKey)
+		in.By = &key
+	}
+
+	return in, nil
+}
+
+func newAggregationKey(target, origin, unmatched interface{}) (AggregationKey, error) {
+	key := AggregationKey{
+		Target: strings.Split(target.(string), "."),
+		Origin: strings.Split(origin.(string), "."),
+	}
+
+	if unmatched != nil {
+		u := unmatched.([]interface{})
+		key.Unmatched = u[3].(string)
+	}
+
+	return key, nil
 }
 
 func newWith(parameterBody, keyValues interface{}) (*Parameters, error) {
@@ -194,9 +336,10 @@ func newWith(parameterBody, keyValues interface{}) (*Parameters, error) {
 	return &p, nil
 }
 
-func newParameterBody(target, functions interface{}) (*ParameterBody, error) {
+func newParameterBody(target, marker, functions interface{}) (*ParameterBody, error) {
 	t := target.(string)
-	pb := ParameterBody{Target: t}
+	_, required := marker.(requiredMarker)
+	pb := ParameterBody{Target: t, Required: required}
 
 	if functions != nil {
 		fns := newFunctionList(functions)
@@ -251,12 +394,12 @@ func newKeyValue(key, value, functions interface{}) (KeyValue, error) {
 	return kv, nil
 }
 
-func newFunctionList(functions interface{}) []string {
+func newFunctionList(functions interface{}) []Function {
 	fns := functions.([]interface{})
-	var result []string
+	var result []Function
 
 	for _, fn := range fns {
-		if fn, ok := fn.(string); ok {
+		if fn, ok := fn.(Function); ok {
 			result = append(result, fn)
 		}
 	}
@@ -264,6 +407,56 @@ func newFunctionList(functions interface{}) []string {
 	return result
 }
 
+// functionArity defines the minimum and maximum number
+// of arguments accepted by the built-in parameter functions.
+// Functions not listed are provided by plugins, which
+// validate their own arguments.
+var functionArity = map[string][2]int{
+	NoMultiplex: {0, 0},
+	Batch:       {1, 1},
+	Cross:       {0, 0},
+	Base64:      {0, 0},
+	JSON:        {0, 0},
+	AsBody:      {0, 0},
+	Flatten:     {0, 0},
+	NoExplode:   {0, 0},
+	AsQuery:     {0, 0},
+	Upper:       {0, 0},
+	Lower:       {0, 0},
+	Trim:        {0, 0},
+	ToInt:       {0, 0},
+	ToString:    {0, 0},
+	URLEncode:   {0, 0},
+	SHA256:      {0, 0},
+	Join:        {0, 1},
+	Split:       {0, 1},
+	HMACSHA256:  {1, 1},
+}
+
+func newFunction(name, args interface{}) (Function, error) {
+	fn := Function{Name: name.(string)}
+	if args, ok := args.([]Value); ok && len(args) > 0 {
+		fn.Arguments = args
+	}
+
+	arity, builtin := functionArity[fn.Name]
+	if builtin && (len(fn.Arguments) < arity[0] || len(fn.Arguments) > arity[1]) {
+		return Function{}, fmt.Errorf("function %s expects between %d and %d arguments, got %d", fn.Name, arity[0], arity[1], len(fn.Arguments))
+	}
+
+	return fn, nil
+}
+
+func newFunctionArgs(first, others interface{}) ([]Value, error) {
+	args := []Value{first.(Value)}
+	for _, o := range others.([]interface{}) {
+		arg := o.([]interface{})
+		args = append(args, arg[3].(Value))
+	}
+
+	return args, nil
+}
+
 func newValue(value interface{}) (Value, error) {
 	switch value := value.(type) {
 	case *Primitive:
@@ -271,6 +464,8 @@ func newValue(value interface{}) (Value, error) {
 	case variable:
 		v := string(value)
 		return Value{Variable: &v}, nil
+	case VariableValue:
+		return Value{Variable: &value.Name, Required: value.Required, Default: value.Default}, nil
 	case []Value:
 		return Value{List: value}, nil
 	case []ObjectEntry:
@@ -280,6 +475,33 @@ func newValue(value interface{}) (Value, error) {
 	}
 }
 
+type requiredMarker struct{}
+
+type defaultValue Value
+
+func newVariableValue(v, modifier interface{}) (VariableValue, error) {
+	vv := VariableValue{Name: string(v.(variable))}
+
+	switch m := modifier.(type) {
+	case requiredMarker:
+		vv.Required = true
+	case defaultValue:
+		d := Value(m)
+		vv.Default = &d
+	}
+
+	return vv, nil
+}
+
+func newRequiredMarker() (requiredMarker, error) {
+	return requiredMarker{}, nil
+}
+
+func newDefaultValue(value interface{}) (defaultValue, error) {
+	v := value.(Value)
+	return defaultValue(v), nil
+}
+
 func newEmptyList() ([]Value, error) {
 	return []Value{}, nil
 }
@@ -391,6 +613,8 @@ func newPrimitive(value interface{}) (*Primitive, error) {
 		p.Boolean = &value
 	case []Chained:
 		p.Chain = value
+	case []TemplatePart:
+		p.Template = value
 	case null:
 		p.Null = true
 	}
@@ -398,6 +622,44 @@ func newPrimitive(value interface{}) (*Primitive, error) {
 	return &p, nil
 }
 
+func newTemplate(head, parts interface{}) ([]TemplatePart, error) {
+	var template []TemplatePart
+	if head != nil {
+		template = append(template, head.(TemplatePart))
+	}
+
+	for _, p := range parts.([]interface{}) {
+		pair := p.([]interface{})
+
+		template = append(template, pair[0].(TemplatePart))
+		if pair[1] != nil {
+			template = append(template, pair[1].(TemplatePart))
+		}
+	}
+
+	return template, nil
+}
+
+func newTemplateText(text []byte) (TemplatePart, error) {
+	t, err := strconv.Unquote(`"` + string(text) + `"`)
+	if err != nil {
+		return TemplatePart{}, err
+	}
+
+	return TemplatePart{Text: &t}, nil
+}
+
+func newTemplateExpression(expr interface{}) (TemplatePart, error) {
+	switch expr := expr.(type) {
+	case VariableValue:
+		return TemplatePart{Variable: &expr}, nil
+	case []Chained:
+		return TemplatePart{Chain: expr}, nil
+	default:
+		return TemplatePart{}, fmt.Errorf("got an unknown type : %T", expr)
+	}
+}
+
 func newOnly(first, others interface{}) ([]Filter, error) {
 	ff := first.(Filter)
 	filters := []Filter{ff}
@@ -419,7 +681,31 @@ func newOnly(first, others interface{}) ([]Filter, error) {
 	return filters, nil
 }
 
-func newFilter(identifier, fns interface{}) (Filter, error) {
+type excludePath []string
+type excludePaths [][]string
+
+func newExclude(first, others interface{}) (excludePaths, error) {
+	paths := excludePaths{first.(excludePath)}
+
+	for _, p := range flatten(others.([]interface{})) {
+		if p, ok := p.(excludePath); ok {
+			paths = append(paths, p)
+		}
+	}
+
+	return paths, nil
+}
+
+func newExcludePath(path interface{}) (excludePath, error) {
+	p, err := newFilterValue(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Split(p, "."), nil
+}
+
+func newFilter(identifier, fns, alias interface{}) (Filter, error) {
 	ident := identifier.(string)
 	fields := strings.Split(ident, ".")
 	filter := Filter{
@@ -427,6 +713,10 @@ func newFilter(identifier, fns interface{}) (Filter, error) {
 		Functions: makeFunctionList(fns),
 	}
 
+	if alias != nil {
+		filter.Alias = alias.(string)
+	}
+
 	return filter, nil
 }
 
@@ -443,6 +733,20 @@ func makeFunctionList(fns interface{}) []interface{} {
 			result = append(result, f)
 		case FilterByRegex:
 			result = append(result, f)
+		case SortBy:
+			result = append(result, f)
+		case Take:
+			result = append(result, f)
+		case Skip:
+			result = append(result, f)
+		case Distinct:
+			result = append(result, f)
+		case IndexBy:
+			result = append(result, f)
+		case GroupBy:
+			result = append(result, f)
+		case Function:
+			result = append(result, f)
 		}
 	}
 
@@ -464,9 +768,8 @@ func newMatchFilter(arg interface{}) (Match, error) {
 	switch arg := arg.(type) {
 	case string:
 		return Match{String: &arg}, nil
-	case variable:
-		matchVar := string(arg)
-		return Match{Variable: &matchVar}, nil
+	case VariableValue:
+		return Match{Variable: &arg}, nil
 	default:
 		return Match{}, errors.New("unexpected matches argument")
 	}
@@ -478,22 +781,101 @@ func newFilterByRegex(path, regex interface{}) (FilterByRegex, error) {
 	switch path := path.(type) {
 	case string:
 		fr.PathString = &path
-	case variable:
-		pathVar := string(path)
-		fr.PathVariable = &pathVar
+	case VariableValue:
+		fr.PathVariable = &path
 	}
 
 	switch regex := regex.(type) {
 	case string:
 		fr.RegexString = &regex
-	case variable:
-		regexVar := string(regex)
-		fr.RegexVariable = &regexVar
+	case VariableValue:
+		fr.RegexVariable = &regex
 	}
 
 	return fr, nil
 }
 
+func newSortBy(path, order interface{}) (SortBy, error) {
+	var sb SortBy
+
+	switch path := path.(type) {
+	case string:
+		sb.PathString = &path
+	case VariableValue:
+		sb.PathVariable = &path
+	}
+
+	if order != nil {
+		o := order.([]interface{})
+
+		switch order := o[3].(type) {
+		case string:
+			sb.OrderString = &order
+		case VariableValue:
+			sb.OrderVariable = &order
+		}
+	}
+
+	return sb, nil
+}
+
+func newTake(n interface{}) (Take, error) {
+	switch n := n.(type) {
+	case VariableValue:
+		return Take{Variable: &n}, nil
+	case int:
+		return Take{Int: &n}, nil
+	default:
+		return Take{}, fmt.Errorf("got an unknown type : %T", n)
+	}
+}
+
+func newSkip(n interface{}) (Skip, error) {
+	switch n := n.(type) {
+	case VariableValue:
+		return Skip{Variable: &n}, nil
+	case int:
+		return Skip{Int: &n}, nil
+	default:
+		return Skip{}, fmt.Errorf("got an unknown type : %T", n)
+	}
+}
+
+func newDistinct(path interface{}) (Distinct, error) {
+	var d Distinct
+
+	switch path := path.(type) {
+	case string:
+		d.PathString = &path
+	case VariableValue:
+		d.PathVariable = &path
+	}
+
+	return d, nil
+}
+
+func newIndexBy(path interface{}) (IndexBy, error) {
+	switch path := path.(type) {
+	case string:
+		return IndexBy{PathString: &path}, nil
+	case VariableValue:
+		return IndexBy{PathVariable: &path}, nil
+	default:
+		return IndexBy{}, fmt.Errorf("got an unknown type : %T", path)
+	}
+}
+
+func newGroupBy(path interface{}) (GroupBy, error) {
+	switch path := path.(type) {
+	case string:
+		return GroupBy{PathString: &path}, nil
+	case VariableValue:
+		return GroupBy{PathVariable: &path}, nil
+	default:
+		return GroupBy{}, fmt.Errorf("got an unknown type : %T", path)
+	}
+}
+
 type hidden bool
 
 func newHidden() (hidden, error) {
@@ -533,13 +915,14 @@ func newHeader(name, value interface{}) (HeaderItem, error) {
 
 func newHeaderValue(value interface{}) (HeaderValue, error) {
 	switch value := value.(type) {
-	case variable:
-		v := string(value)
-		return HeaderValue{Variable: &v}, nil
+	case VariableValue:
+		return HeaderValue{Variable: &value.Name, Required: value.Required, Default: value.Default}, nil
 	case string:
 		return HeaderValue{String: &value}, nil
 	case []Chained:
 		return HeaderValue{Chain: value}, nil
+	case []TemplatePart:
+		return HeaderValue{Template: value}, nil
 	default:
 		return HeaderValue{}, fmt.Errorf("got an unknown type : %T", value)
 	}
@@ -581,9 +964,114 @@ func newSmaxAge(value interface{}) (*SMaxAgeValue, error) {
 	}
 }
 
-func newDependsOn(target interface{}) (DependsOnValue, error) {
-	d := target.(string)
-	return DependsOnValue(d), nil
+func newDependsOn(target, condition interface{}) (*DependsOnValue, error) {
+	var d DependsOnValue
+
+	switch target := target.(type) {
+	case string:
+		d.Targets = []string{target}
+	case []string:
+		d.Targets = target
+	default:
+		return nil, fmt.Errorf("got an unknown type : %T", target)
+	}
+
+	if condition != nil {
+		d.Condition = condition.(string)
+	}
+
+	return &d, nil
+}
+
+func newDependsOnTargets(first, others interface{}) ([]string, error) {
+	targets := []string{first.(string)}
+
+	if others != nil {
+		for _, t := range flatten(others.([]interface{})) {
+			if t, ok := t.(string); ok {
+				targets = append(targets, t)
+			}
+		}
+	}
+
+	return targets, nil
+}
+
+func newWhen(left, right interface{}) (*Condition, error) {
+	l := left.(Value)
+	condition := Condition{Left: l}
+
+	if right != nil {
+		r := right.([]interface{})
+		operator := r[1].(string)
+		operand := r[3].(Value)
+
+		condition.Operator = operator
+		condition.Right = &operand
+	}
+
+	return &condition, nil
+}
+
+func newPaginate(param, path, maxPages interface{}) (*Pagination, error) {
+	p := Pagination{
+		Param: param.(string),
+		Path:  strings.Split(path.(string), "."),
+	}
+
+	if maxPages != nil {
+		m := maxPages.([]interface{})
+
+		switch value := m[3].(type) {
+		case variable:
+			v := string(value)
+			p.MaxPages = &MaxPagesValue{Variable: &v}
+		case int:
+			p.MaxPages = &MaxPagesValue{Int: &value}
+		default:
+			return nil, fmt.Errorf("got an unknown type : %T", value)
+		}
+	}
+
+	return &p, nil
+}
+
+func newRetry(attempts, backoff, unsafe interface{}) (*Retry, error) {
+	a, err := newRetryValue(attempts)
+	if err != nil {
+		return nil, err
+	}
+
+	r := Retry{Attempts: a, Unsafe: unsafe != nil}
+
+	if backoff != nil {
+		b := backoff.([]interface{})
+
+		bv, err := newRetryValue(b[3])
+		if err != nil {
+			return nil, err
+		}
+
+		r.Backoff = &bv
+	}
+
+	return &r, nil
+}
+
+func newRetryValue(value interface{}) (RetryValue, error) {
+	switch value := value.(type) {
+	case variable:
+		v := string(value)
+		return RetryValue{Variable: &v}, nil
+	case int:
+		return RetryValue{Int: &value}, nil
+	default:
+		return RetryValue{}, fmt.Errorf("got an unknown type : %T", value)
+	}
+}
+
+func newOnError(value interface{}) (*OnError, error) {
+	return &OnError{Default: value.(Value)}, nil
 }
 
 type ignoreErrors bool
@@ -0,0 +1,276 @@
+package ast
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ParseError represents a query that does not comply with
+// the restQL syntax, locating the offending token and
+// giving hints on how to fix it.
+type ParseError struct {
+	Line       int
+	Column     int
+	Token      string
+	Expected   []string
+	Suggestion string
+	Message    string
+}
+
+func (pe ParseError) Error() string {
+	msg := fmt.Sprintf("line %d, column %d: %s", pe.Line, pe.Column, pe.Message)
+	if pe.Suggestion != "" {
+		msg += ": " + pe.Suggestion
+	}
+
+	return msg
+}
+
+const maxTokenLength = 30
+
+// clauseOrder defines the position each statement
+// clause must follow in relation to the others.
+var clauseOrder = map[string]int{
+	HeadersKeyword:      1,
+	TimeoutKeyword:      1,
+	MaxAgeKeyword:       1,
+	SmaxAgeKeyword:      1,
+	DependsOnKeyword:    1,
+	WhenKeyword:         1,
+	PaginateKeyword:     1,
+	RetryKeyword:        1,
+	OnErrorKeyword:      1,
+	WithKeyword:         2,
+	OnlyKeyword:         3,
+	ExcludeKeyword:      3,
+	HiddenKeyword:       3,
+	IgnoreErrorsKeyword: 4,
+}
+
+const clauseOrderDescription = "headers, timeout, max-age, s-max-age, depends-on, when, paginate, retry and on-error, " +
+	"then with, then only, exclude or hidden, then ignore-errors"
+
+// filterClauses can not be declared together on a statement.
+var filterClauses = map[string]bool{
+	OnlyKeyword:    true,
+	ExcludeKeyword: true,
+	HiddenKeyword:  true,
+}
+
+const exclusiveFiltersSuggestion = "the only, exclude and hidden clauses are mutually exclusive, a statement can declare just one of them"
+
+// newParseError builds a ParseError from the first
+// failure reported by the generated parser.
+func newParseError(query []byte, err error) error {
+	errs, ok := err.(errList)
+	if !ok || len(errs) == 0 {
+		return err
+	}
+
+	pErr, ok := errs[0].(*parserError)
+	if !ok {
+		return err
+	}
+
+	token := tokenAt(query, pErr.pos.offset)
+	expected := cleanExpected(pErr.expected)
+
+	pe := ParseError{
+		Line:     pErr.pos.line,
+		Column:   pErr.pos.col,
+		Token:    token,
+		Expected: expected,
+	}
+
+	switch {
+	case len(pErr.expected) == 0:
+		pe.Message = pErr.Inner.Error()
+	case token == "":
+		pe.Message = "unexpected end of query"
+	default:
+		pe.Message = fmt.Sprintf("unexpected %q", token)
+	}
+
+	pe.Suggestion = suggest(token, previousToken(query, pErr.pos.offset), expected)
+
+	return pe
+}
+
+func tokenAt(query []byte, offset int) string {
+	if offset >= len(query) {
+		return ""
+	}
+
+	rest := strings.TrimLeftFunc(string(query[offset:]), unicode.IsSpace)
+	end := strings.IndexFunc(rest, unicode.IsSpace)
+	if end < 0 {
+		end = len(rest)
+	}
+
+	token := rest[:end]
+	if len(token) > maxTokenLength {
+		token = token[:maxTokenLength]
+	}
+
+	return token
+}
+
+// previousToken returns the word preceding the given offset
+// if it is the first one on its line.
+func previousToken(query []byte, offset int) string {
+	if offset > len(query) {
+		offset = len(query)
+	}
+
+	before := strings.TrimRightFunc(string(query[:offset]), unicode.IsSpace)
+	start := strings.LastIndexFunc(before, unicode.IsSpace)
+	word := before[start+1:]
+
+	lineStart := strings.LastIndex(before, "\n")
+	if strings.TrimSpace(before[lineStart+1:]) != word {
+		return ""
+	}
+
+	return word
+}
+
+func cleanExpected(expected []string) []string {
+	var result []string
+	for _, e := range expected {
+		s, err := strconv.Unquote(e)
+		if err != nil || strings.TrimSpace(s) == "" || s == "//" {
+			continue
+		}
+
+		result = append(result, s)
+	}
+
+	return result
+}
+
+func suggest(token, previous string, expected []string) string {
+	if filterClauses[token] && !contains(expected, token) && contains(expected, IgnoreErrorsKeyword) {
+		return exclusiveFiltersSuggestion
+	}
+
+	if _, isClause := clauseOrder[token]; isClause && !contains(expected, token) {
+		return outOfOrderSuggestion(token)
+	}
+
+	if filterClauses[previous] && contains(expected, IgnoreErrorsKeyword) {
+		return exclusiveFiltersSuggestion
+	}
+
+	inFilterList := contains(expected, "->") && contains(expected, "as")
+
+	if _, isClause := clauseOrder[previous]; isClause && inFilterList {
+		return outOfOrderSuggestion(previous)
+	}
+
+	if keyword, found := closestKeyword(token, expected); found {
+		return fmt.Sprintf("did you mean %q?", keyword)
+	}
+
+	return ""
+}
+
+func outOfOrderSuggestion(clause string) string {
+	return fmt.Sprintf("the %s clause is out of order, clauses must be declared as %s", clause, clauseOrderDescription)
+}
+
+func closestKeyword(token string, keywords []string) (string, bool) {
+	if token == "" {
+		return "", false
+	}
+
+	best, bestDistance := "", -1
+	for _, k := range keywords {
+		if !isKeyword(k) {
+			continue
+		}
+
+		d := editDistance(token, k)
+		if d > maxEditDistance(k) {
+			continue
+		}
+
+		if bestDistance < 0 || d < bestDistance {
+			best, bestDistance = k, d
+		}
+	}
+
+	return best, bestDistance >= 0
+}
+
+func isKeyword(s string) bool {
+	for _, r := range s {
+		if !unicode.IsLetter(r) && r != '-' {
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+func maxEditDistance(keyword string) int {
+	if len(keyword) <= 4 {
+		return 1
+	}
+
+	return 2
+}
+
+// editDistance calculates the optimal string alignment distance,
+// which counts the transposition of adjacent characters as a
+// single edit, as it is a common typing mistake.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	d := make([][]int, len(ra)+1)
+	for i := range d {
+		d[i] = make([]int, len(rb)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return d[len(ra)][len(rb)]
+}
+
+func min(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+
+	return m
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+
+	return false
+}
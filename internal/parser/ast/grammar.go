@@ -60,52 +60,66 @@ var g = &grammar{
 								},
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 17, col: 44, offset: 161},
+							label: "is",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 17, col: 47, offset: 164},
+								expr: &ruleRefExpr{
+									pos:  position{line: 17, col: 48, offset: 165},
+									name: "INCLUDE",
+								},
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 17, col: 44, offset: 161},
+							pos:  position{line: 17, col: 58, offset: 175},
 							name: "WS",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 17, col: 47, offset: 164},
+							pos: position{line: 17, col: 61, offset: 178},
 							expr: &choiceExpr{
-								pos: position{line: 17, col: 48, offset: 165},
+								pos: position{line: 17, col: 62, offset: 179},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 17, col: 48, offset: 165},
+										pos:  position{line: 17, col: 62, offset: 179},
 										name: "NL",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 17, col: 53, offset: 170},
+										pos:  position{line: 17, col: 67, offset: 184},
 										name: "COMMENT",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 17, col: 63, offset: 180},
+							pos:  position{line: 17, col: 77, offset: 194},
 							name: "WS",
 						},
 						&labeledExpr{
-							pos:   position{line: 17, col: 66, offset: 183},
+							pos:   position{line: 17, col: 80, offset: 197},
 							label: "firstBlock",
-							expr: &ruleRefExpr{
-								pos:  position{line: 17, col: 77, offset: 194},
-								name: "BLOCK",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 17, col: 91, offset: 208},
+								expr: &ruleRefExpr{
+									pos:  position{line: 17, col: 91, offset: 208},
+									name: "BLOCK",
+								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 17, col: 83, offset: 200},
+							pos:   position{line: 17, col: 98, offset: 215},
 							label: "otherBlocks",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 17, col: 95, offset: 212},
+								pos: position{line: 17, col: 110, offset: 227},
 								expr: &seqExpr{
-									pos: position{line: 17, col: 96, offset: 213},
+									pos: position{line: 17, col: 111, offset: 228},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 17, col: 96, offset: 213},
+											pos:  position{line: 17, col: 111, offset: 228},
 											name: "BS",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 17, col: 99, offset: 216},
+											pos:  position{line: 17, col: 114, offset: 231},
 											name: "BLOCK",
 										},
 									},
@@ -113,27 +127,27 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 17, col: 107, offset: 224},
+							pos: position{line: 17, col: 122, offset: 239},
 							expr: &choiceExpr{
-								pos: position{line: 17, col: 108, offset: 225},
+								pos: position{line: 17, col: 123, offset: 240},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 17, col: 108, offset: 225},
+										pos:  position{line: 17, col: 123, offset: 240},
 										name: "NL",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 17, col: 113, offset: 230},
+										pos:  position{line: 17, col: 128, offset: 245},
 										name: "SPACE",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 17, col: 121, offset: 238},
+										pos:  position{line: 17, col: 136, offset: 253},
 										name: "COMMENT",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 17, col: 131, offset: 248},
+							pos:  position{line: 17, col: 146, offset: 263},
 							name: "EOF",
 						},
 					},
@@ -142,56 +156,83 @@ var g = &grammar{
 		},
 		{
 			name: "USE",
-			pos:  position{line: 21, col: 1, offset: 303},
+			pos:  position{line: 21, col: 1, offset: 322},
 			expr: &actionExpr{
-				pos: position{line: 21, col: 8, offset: 310},
+				pos: position{line: 21, col: 8, offset: 329},
 				run: (*parser).callonUSE1,
+				expr: &labeledExpr{
+					pos:   position{line: 21, col: 8, offset: 329},
+					label: "u",
+					expr: &choiceExpr{
+						pos: position{line: 21, col: 11, offset: 332},
+						alternatives: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 21, col: 11, offset: 332},
+								name: "USE_PARAMS",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 21, col: 24, offset: 345},
+								name: "USE_MULTIPLEX",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 21, col: 40, offset: 361},
+								name: "USE_MODIFIER",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "USE_MULTIPLEX",
+			pos:  position{line: 25, col: 1, offset: 395},
+			expr: &actionExpr{
+				pos: position{line: 25, col: 18, offset: 412},
+				run: (*parser).callonUSE_MULTIPLEX1,
 				expr: &seqExpr{
-					pos: position{line: 21, col: 8, offset: 310},
+					pos: position{line: 25, col: 18, offset: 412},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 21, col: 8, offset: 310},
+							pos:        position{line: 25, col: 18, offset: 412},
 							val:        "use",
 							ignoreCase: false,
 							want:       "\"use\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 21, col: 14, offset: 316},
+							pos:  position{line: 25, col: 24, offset: 418},
 							name: "WS_MAND",
 						},
-						&labeledExpr{
-							pos:   position{line: 21, col: 22, offset: 324},
-							label: "r",
-							expr: &ruleRefExpr{
-								pos:  position{line: 21, col: 25, offset: 327},
-								name: "USE_ACTION",
-							},
+						&litMatcher{
+							pos:        position{line: 25, col: 32, offset: 426},
+							val:        "multiplex",
+							ignoreCase: false,
+							want:       "\"multiplex\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 21, col: 37, offset: 339},
-							name: "WS",
+							pos:  position{line: 25, col: 44, offset: 438},
+							name: "WS_MAND",
 						},
 						&labeledExpr{
-							pos:   position{line: 21, col: 40, offset: 342},
-							label: "v",
+							pos:   position{line: 25, col: 52, offset: 446},
+							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 21, col: 43, offset: 345},
-								name: "USE_VALUE",
+								pos:  position{line: 25, col: 55, offset: 449},
+								name: "MULTIPLEX_MODE",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 21, col: 54, offset: 356},
+							pos:  position{line: 25, col: 71, offset: 465},
 							name: "WS",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 21, col: 57, offset: 359},
+							pos: position{line: 25, col: 74, offset: 468},
 							expr: &ruleRefExpr{
-								pos:  position{line: 21, col: 57, offset: 359},
+								pos:  position{line: 25, col: 74, offset: 468},
 								name: "LS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 21, col: 61, offset: 363},
+							pos:  position{line: 25, col: 78, offset: 472},
 							name: "WS",
 						},
 					},
@@ -199,133 +240,221 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "USE_ACTION",
-			pos:  position{line: 25, col: 1, offset: 392},
+			name: "MULTIPLEX_MODE",
+			pos:  position{line: 29, col: 1, offset: 507},
 			expr: &actionExpr{
-				pos: position{line: 25, col: 15, offset: 406},
-				run: (*parser).callonUSE_ACTION1,
+				pos: position{line: 29, col: 19, offset: 525},
+				run: (*parser).callonMULTIPLEX_MODE1,
 				expr: &choiceExpr{
-					pos: position{line: 25, col: 16, offset: 407},
+					pos: position{line: 29, col: 20, offset: 526},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 25, col: 16, offset: 407},
-							val:        "timeout",
-							ignoreCase: false,
-							want:       "\"timeout\"",
-						},
-						&litMatcher{
-							pos:        position{line: 25, col: 28, offset: 419},
-							val:        "max-age",
+							pos:        position{line: 29, col: 20, offset: 526},
+							val:        "cross",
 							ignoreCase: false,
-							want:       "\"max-age\"",
+							want:       "\"cross\"",
 						},
 						&litMatcher{
-							pos:        position{line: 25, col: 40, offset: 431},
-							val:        "s-max-age",
+							pos:        position{line: 29, col: 30, offset: 536},
+							val:        "zip",
 							ignoreCase: false,
-							want:       "\"s-max-age\"",
+							want:       "\"zip\"",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "USE_VALUE",
-			pos:  position{line: 29, col: 1, offset: 475},
+			name: "USE_MODIFIER",
+			pos:  position{line: 33, col: 1, offset: 574},
 			expr: &actionExpr{
-				pos: position{line: 29, col: 14, offset: 488},
-				run: (*parser).callonUSE_VALUE1,
-				expr: &labeledExpr{
-					pos:   position{line: 29, col: 14, offset: 488},
-					label: "v",
-					expr: &choiceExpr{
-						pos: position{line: 29, col: 17, offset: 491},
-						alternatives: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 29, col: 17, offset: 491},
-								name: "String",
+				pos: position{line: 33, col: 17, offset: 590},
+				run: (*parser).callonUSE_MODIFIER1,
+				expr: &seqExpr{
+					pos: position{line: 33, col: 17, offset: 590},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 33, col: 17, offset: 590},
+							val:        "use",
+							ignoreCase: false,
+							want:       "\"use\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 33, col: 23, offset: 596},
+							name: "WS_MAND",
+						},
+						&labeledExpr{
+							pos:   position{line: 33, col: 31, offset: 604},
+							label: "r",
+							expr: &ruleRefExpr{
+								pos:  position{line: 33, col: 34, offset: 607},
+								name: "USE_ACTION",
 							},
-							&ruleRefExpr{
-								pos:  position{line: 29, col: 26, offset: 500},
-								name: "Integer",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 33, col: 46, offset: 619},
+							name: "WS",
+						},
+						&labeledExpr{
+							pos:   position{line: 33, col: 49, offset: 622},
+							label: "v",
+							expr: &ruleRefExpr{
+								pos:  position{line: 33, col: 52, offset: 625},
+								name: "USE_VALUE",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 33, col: 63, offset: 636},
+							name: "WS",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 33, col: 66, offset: 639},
+							expr: &ruleRefExpr{
+								pos:  position{line: 33, col: 66, offset: 639},
+								name: "LS",
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 33, col: 70, offset: 643},
+							name: "WS",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "BLOCK",
-			pos:  position{line: 33, col: 1, offset: 537},
+			name: "USE_PARAMS",
+			pos:  position{line: 37, col: 1, offset: 672},
 			expr: &actionExpr{
-				pos: position{line: 33, col: 10, offset: 546},
-				run: (*parser).callonBLOCK1,
+				pos: position{line: 37, col: 15, offset: 686},
+				run: (*parser).callonUSE_PARAMS1,
 				expr: &seqExpr{
-					pos: position{line: 33, col: 10, offset: 546},
+					pos: position{line: 37, col: 15, offset: 686},
 					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 33, col: 10, offset: 546},
-							label: "action",
+						&litMatcher{
+							pos:        position{line: 37, col: 15, offset: 686},
+							val:        "use",
+							ignoreCase: false,
+							want:       "\"use\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 37, col: 21, offset: 692},
+							name: "WS_MAND",
+						},
+						&litMatcher{
+							pos:        position{line: 37, col: 29, offset: 700},
+							val:        "params",
+							ignoreCase: false,
+							want:       "\"params\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 37, col: 38, offset: 709},
+							name: "WS",
+						},
+						&litMatcher{
+							pos:        position{line: 37, col: 41, offset: 712},
+							val:        "{",
+							ignoreCase: false,
+							want:       "\"{\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 37, col: 45, offset: 716},
+							name: "WS",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 37, col: 48, offset: 719},
 							expr: &ruleRefExpr{
-								pos:  position{line: 33, col: 18, offset: 554},
-								name: "ACTION_RULE",
+								pos:  position{line: 37, col: 48, offset: 719},
+								name: "NL",
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 33, col: 31, offset: 567},
-							label: "m",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 33, col: 34, offset: 570},
-								expr: &ruleRefExpr{
-									pos:  position{line: 33, col: 34, offset: 570},
-									name: "MODIFIER_RULE",
-								},
-							},
+						&ruleRefExpr{
+							pos:  position{line: 37, col: 52, offset: 723},
+							name: "WS",
 						},
 						&labeledExpr{
-							pos:   position{line: 33, col: 50, offset: 586},
-							label: "w",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 33, col: 53, offset: 589},
-								expr: &ruleRefExpr{
-									pos:  position{line: 33, col: 53, offset: 589},
-									name: "WITH_RULE",
-								},
+							pos:   position{line: 37, col: 55, offset: 726},
+							label: "p",
+							expr: &ruleRefExpr{
+								pos:  position{line: 37, col: 58, offset: 729},
+								name: "PARAM_DECLARATION",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 33, col: 65, offset: 601},
-							label: "f",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 33, col: 67, offset: 603},
-								expr: &choiceExpr{
-									pos: position{line: 33, col: 68, offset: 604},
-									alternatives: []interface{}{
+							pos:   position{line: 37, col: 77, offset: 748},
+							label: "ps",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 37, col: 80, offset: 751},
+								expr: &seqExpr{
+									pos: position{line: 37, col: 81, offset: 752},
+									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 33, col: 68, offset: 604},
-											name: "HIDDEN_RULE",
+											pos:  position{line: 37, col: 81, offset: 752},
+											name: "WS",
+										},
+										&litMatcher{
+											pos:        position{line: 37, col: 84, offset: 755},
+											val:        ",",
+											ignoreCase: false,
+											want:       "\",\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 33, col: 82, offset: 618},
-											name: "ONLY_RULE",
+											pos:  position{line: 37, col: 88, offset: 759},
+											name: "WS",
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 37, col: 91, offset: 762},
+											expr: &ruleRefExpr{
+												pos:  position{line: 37, col: 91, offset: 762},
+												name: "NL",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 37, col: 95, offset: 766},
+											name: "WS",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 37, col: 98, offset: 769},
+											name: "PARAM_DECLARATION",
 										},
 									},
 								},
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 33, col: 94, offset: 630},
-							label: "fl",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 33, col: 98, offset: 634},
-								expr: &ruleRefExpr{
-									pos:  position{line: 33, col: 98, offset: 634},
-									name: "FLAGS_RULE",
-								},
+						&ruleRefExpr{
+							pos:  position{line: 37, col: 118, offset: 789},
+							name: "WS",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 37, col: 121, offset: 792},
+							expr: &ruleRefExpr{
+								pos:  position{line: 37, col: 121, offset: 792},
+								name: "NL",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 37, col: 125, offset: 796},
+							name: "WS",
+						},
+						&litMatcher{
+							pos:        position{line: 37, col: 128, offset: 799},
+							val:        "}",
+							ignoreCase: false,
+							want:       "\"}\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 37, col: 132, offset: 803},
+							name: "WS",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 37, col: 135, offset: 806},
+							expr: &ruleRefExpr{
+								pos:  position{line: 37, col: 135, offset: 806},
+								name: "LS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 33, col: 111, offset: 647},
+							pos:  position{line: 37, col: 139, offset: 810},
 							name: "WS",
 						},
 					},
@@ -333,53 +462,93 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ACTION_RULE",
-			pos:  position{line: 37, col: 1, offset: 693},
+			name: "PARAM_DECLARATION",
+			pos:  position{line: 41, col: 1, offset: 846},
 			expr: &actionExpr{
-				pos: position{line: 37, col: 16, offset: 708},
-				run: (*parser).callonACTION_RULE1,
+				pos: position{line: 41, col: 22, offset: 867},
+				run: (*parser).callonPARAM_DECLARATION1,
 				expr: &seqExpr{
-					pos: position{line: 37, col: 16, offset: 708},
+					pos: position{line: 41, col: 22, offset: 867},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 37, col: 16, offset: 708},
-							label: "m",
+							pos:   position{line: 41, col: 22, offset: 867},
+							label: "n",
 							expr: &ruleRefExpr{
-								pos:  position{line: 37, col: 19, offset: 711},
-								name: "METHOD",
+								pos:  position{line: 41, col: 25, offset: 870},
+								name: "IDENT_WITHOUT_COLLON",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 37, col: 27, offset: 719},
-							name: "WS_MAND",
+							pos:  position{line: 41, col: 47, offset: 892},
+							name: "WS",
+						},
+						&litMatcher{
+							pos:        position{line: 41, col: 50, offset: 895},
+							val:        ":",
+							ignoreCase: false,
+							want:       "\":\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 41, col: 54, offset: 899},
+							name: "WS",
 						},
 						&labeledExpr{
-							pos:   position{line: 37, col: 35, offset: 727},
-							label: "r",
+							pos:   position{line: 41, col: 57, offset: 902},
+							label: "t",
 							expr: &ruleRefExpr{
-								pos:  position{line: 37, col: 38, offset: 730},
-								name: "IDENT",
+								pos:  position{line: 41, col: 60, offset: 905},
+								name: "PARAM_TYPE",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 37, col: 45, offset: 737},
-							label: "a",
+							pos:   position{line: 41, col: 72, offset: 917},
+							label: "r",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 37, col: 48, offset: 740},
-								expr: &ruleRefExpr{
-									pos:  position{line: 37, col: 48, offset: 740},
-									name: "ALIAS",
+								pos: position{line: 41, col: 74, offset: 919},
+								expr: &seqExpr{
+									pos: position{line: 41, col: 75, offset: 920},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 41, col: 75, offset: 920},
+											name: "WS_MAND",
+										},
+										&litMatcher{
+											pos:        position{line: 41, col: 83, offset: 928},
+											val:        "required",
+											ignoreCase: false,
+											want:       "\"required\"",
+										},
+									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 37, col: 56, offset: 748},
-							label: "i",
+							pos:   position{line: 41, col: 96, offset: 941},
+							label: "d",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 37, col: 59, offset: 751},
-								expr: &ruleRefExpr{
-									pos:  position{line: 37, col: 59, offset: 751},
-									name: "IN",
+								pos: position{line: 41, col: 98, offset: 943},
+								expr: &seqExpr{
+									pos: position{line: 41, col: 99, offset: 944},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 41, col: 99, offset: 944},
+											name: "WS",
+										},
+										&litMatcher{
+											pos:        position{line: 41, col: 102, offset: 947},
+											val:        "=",
+											ignoreCase: false,
+											want:       "\"=\"",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 41, col: 106, offset: 951},
+											name: "WS",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 41, col: 109, offset: 954},
+											name: "VALUE",
+										},
+									},
 								},
 							},
 						},
@@ -388,152 +557,204 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "METHOD",
-			pos:  position{line: 41, col: 1, offset: 795},
+			name: "PARAM_TYPE",
+			pos:  position{line: 45, col: 1, offset: 1007},
 			expr: &actionExpr{
-				pos: position{line: 41, col: 11, offset: 805},
-				run: (*parser).callonMETHOD1,
+				pos: position{line: 45, col: 15, offset: 1021},
+				run: (*parser).callonPARAM_TYPE1,
+				expr: &labeledExpr{
+					pos:   position{line: 45, col: 15, offset: 1021},
+					label: "t",
+					expr: &choiceExpr{
+						pos: position{line: 45, col: 18, offset: 1024},
+						alternatives: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 45, col: 18, offset: 1024},
+								name: "PARAM_ENUM",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 45, col: 31, offset: 1037},
+								name: "PARAM_SCALAR",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PARAM_SCALAR",
+			pos:  position{line: 49, col: 1, offset: 1071},
+			expr: &actionExpr{
+				pos: position{line: 49, col: 17, offset: 1087},
+				run: (*parser).callonPARAM_SCALAR1,
 				expr: &choiceExpr{
-					pos: position{line: 41, col: 12, offset: 806},
+					pos: position{line: 49, col: 18, offset: 1088},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 41, col: 12, offset: 806},
-							val:        "from",
+							pos:        position{line: 49, col: 18, offset: 1088},
+							val:        "string",
 							ignoreCase: false,
-							want:       "\"from\"",
+							want:       "\"string\"",
 						},
 						&litMatcher{
-							pos:        position{line: 41, col: 21, offset: 815},
-							val:        "to",
+							pos:        position{line: 49, col: 29, offset: 1099},
+							val:        "int",
 							ignoreCase: false,
-							want:       "\"to\"",
+							want:       "\"int\"",
 						},
 						&litMatcher{
-							pos:        position{line: 41, col: 28, offset: 822},
-							val:        "into",
+							pos:        position{line: 49, col: 37, offset: 1107},
+							val:        "float",
 							ignoreCase: false,
-							want:       "\"into\"",
+							want:       "\"float\"",
 						},
 						&litMatcher{
-							pos:        position{line: 41, col: 36, offset: 830},
-							val:        "update",
+							pos:        position{line: 49, col: 47, offset: 1117},
+							val:        "boolean",
 							ignoreCase: false,
-							want:       "\"update\"",
+							want:       "\"boolean\"",
 						},
 						&litMatcher{
-							pos:        position{line: 41, col: 47, offset: 841},
-							val:        "delete",
+							pos:        position{line: 49, col: 59, offset: 1129},
+							val:        "list",
 							ignoreCase: false,
-							want:       "\"delete\"",
+							want:       "\"list\"",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "ALIAS",
-			pos:  position{line: 45, col: 1, offset: 882},
+			name: "PARAM_ENUM",
+			pos:  position{line: 53, col: 1, offset: 1171},
 			expr: &actionExpr{
-				pos: position{line: 45, col: 10, offset: 891},
-				run: (*parser).callonALIAS1,
+				pos: position{line: 53, col: 15, offset: 1185},
+				run: (*parser).callonPARAM_ENUM1,
 				expr: &seqExpr{
-					pos: position{line: 45, col: 10, offset: 891},
+					pos: position{line: 53, col: 15, offset: 1185},
 					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 53, col: 15, offset: 1185},
+							val:        "enum",
+							ignoreCase: false,
+							want:       "\"enum\"",
+						},
 						&ruleRefExpr{
-							pos:  position{line: 45, col: 10, offset: 891},
-							name: "WS_MAND",
+							pos:  position{line: 53, col: 22, offset: 1192},
+							name: "WS",
 						},
 						&litMatcher{
-							pos:        position{line: 45, col: 18, offset: 899},
-							val:        "as",
+							pos:        position{line: 53, col: 25, offset: 1195},
+							val:        "(",
 							ignoreCase: false,
-							want:       "\"as\"",
+							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 45, col: 23, offset: 904},
-							name: "WS_MAND",
+							pos:  position{line: 53, col: 29, offset: 1199},
+							name: "WS",
 						},
 						&labeledExpr{
-							pos:   position{line: 45, col: 31, offset: 912},
-							label: "a",
+							pos:   position{line: 53, col: 32, offset: 1202},
+							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 45, col: 34, offset: 915},
-								name: "IDENT",
+								pos:  position{line: 53, col: 35, offset: 1205},
+								name: "String",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 53, col: 43, offset: 1213},
+							label: "vs",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 53, col: 46, offset: 1216},
+								expr: &seqExpr{
+									pos: position{line: 53, col: 47, offset: 1217},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 53, col: 47, offset: 1217},
+											name: "WS",
+										},
+										&litMatcher{
+											pos:        position{line: 53, col: 50, offset: 1220},
+											val:        ",",
+											ignoreCase: false,
+											want:       "\",\"",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 53, col: 54, offset: 1224},
+											name: "WS",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 53, col: 57, offset: 1227},
+											name: "String",
+										},
+									},
+								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 53, col: 66, offset: 1236},
+							name: "WS",
+						},
+						&litMatcher{
+							pos:        position{line: 53, col: 69, offset: 1239},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "IN",
-			pos:  position{line: 49, col: 1, offset: 942},
+			name: "USE_ACTION",
+			pos:  position{line: 57, col: 1, offset: 1276},
 			expr: &actionExpr{
-				pos: position{line: 49, col: 7, offset: 948},
-				run: (*parser).callonIN1,
-				expr: &seqExpr{
-					pos: position{line: 49, col: 7, offset: 948},
-					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 49, col: 7, offset: 948},
-							name: "WS_MAND",
-						},
+				pos: position{line: 57, col: 15, offset: 1290},
+				run: (*parser).callonUSE_ACTION1,
+				expr: &choiceExpr{
+					pos: position{line: 57, col: 16, offset: 1291},
+					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 49, col: 15, offset: 956},
-							val:        "in",
+							pos:        position{line: 57, col: 16, offset: 1291},
+							val:        "timeout",
 							ignoreCase: false,
-							want:       "\"in\"",
+							want:       "\"timeout\"",
 						},
-						&ruleRefExpr{
-							pos:  position{line: 49, col: 20, offset: 961},
-							name: "WS_MAND",
+						&litMatcher{
+							pos:        position{line: 57, col: 28, offset: 1303},
+							val:        "max-age",
+							ignoreCase: false,
+							want:       "\"max-age\"",
 						},
-						&labeledExpr{
-							pos:   position{line: 49, col: 28, offset: 969},
-							label: "t",
-							expr: &ruleRefExpr{
-								pos:  position{line: 49, col: 31, offset: 972},
-								name: "IDENT_WITH_DOT",
-							},
+						&litMatcher{
+							pos:        position{line: 57, col: 40, offset: 1315},
+							val:        "s-max-age",
+							ignoreCase: false,
+							want:       "\"s-max-age\"",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "MODIFIER_RULE",
-			pos:  position{line: 53, col: 1, offset: 1010},
+			name: "USE_VALUE",
+			pos:  position{line: 61, col: 1, offset: 1359},
 			expr: &actionExpr{
-				pos: position{line: 53, col: 18, offset: 1027},
-				run: (*parser).callonMODIFIER_RULE1,
+				pos: position{line: 61, col: 14, offset: 1372},
+				run: (*parser).callonUSE_VALUE1,
 				expr: &labeledExpr{
-					pos:   position{line: 53, col: 18, offset: 1027},
-					label: "m",
-					expr: &oneOrMoreExpr{
-						pos: position{line: 53, col: 20, offset: 1029},
-						expr: &choiceExpr{
-							pos: position{line: 53, col: 21, offset: 1030},
-							alternatives: []interface{}{
-								&ruleRefExpr{
-									pos:  position{line: 53, col: 21, offset: 1030},
-									name: "HEADERS",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 53, col: 31, offset: 1040},
-									name: "TIMEOUT",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 53, col: 41, offset: 1050},
-									name: "MAX_AGE",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 53, col: 51, offset: 1060},
-									name: "S_MAX_AGE",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 53, col: 63, offset: 1072},
-									name: "DEPENDS_ON",
-								},
+					pos:   position{line: 61, col: 14, offset: 1372},
+					label: "v",
+					expr: &choiceExpr{
+						pos: position{line: 61, col: 17, offset: 1375},
+						alternatives: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 61, col: 17, offset: 1375},
+								name: "String",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 61, col: 26, offset: 1384},
+								name: "Integer",
 							},
 						},
 					},
@@ -541,101 +762,84 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "WITH_RULE",
-			pos:  position{line: 57, col: 1, offset: 1105},
+			name: "INCLUDE",
+			pos:  position{line: 65, col: 1, offset: 1421},
 			expr: &actionExpr{
-				pos: position{line: 57, col: 14, offset: 1118},
-				run: (*parser).callonWITH_RULE1,
+				pos: position{line: 65, col: 12, offset: 1432},
+				run: (*parser).callonINCLUDE1,
 				expr: &seqExpr{
-					pos: position{line: 57, col: 14, offset: 1118},
+					pos: position{line: 65, col: 12, offset: 1432},
 					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 57, col: 14, offset: 1118},
-							name: "WS_MAND",
-						},
 						&litMatcher{
-							pos:        position{line: 57, col: 22, offset: 1126},
-							val:        "with",
+							pos:        position{line: 65, col: 12, offset: 1432},
+							val:        "include",
 							ignoreCase: false,
-							want:       "\"with\"",
+							want:       "\"include\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 57, col: 29, offset: 1133},
+							pos:  position{line: 65, col: 22, offset: 1442},
 							name: "WS_MAND",
 						},
 						&labeledExpr{
-							pos:   position{line: 57, col: 37, offset: 1141},
-							label: "pb",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 57, col: 40, offset: 1144},
-								expr: &ruleRefExpr{
-									pos:  position{line: 57, col: 40, offset: 1144},
-									name: "PARAMETER_BODY",
-								},
+							pos:   position{line: 65, col: 30, offset: 1450},
+							label: "ns",
+							expr: &ruleRefExpr{
+								pos:  position{line: 65, col: 34, offset: 1454},
+								name: "IDENT",
 							},
 						},
+						&litMatcher{
+							pos:        position{line: 65, col: 41, offset: 1461},
+							val:        "/",
+							ignoreCase: false,
+							want:       "\"/\"",
+						},
 						&labeledExpr{
-							pos:   position{line: 57, col: 56, offset: 1160},
-							label: "kvs",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 57, col: 60, offset: 1164},
-								expr: &ruleRefExpr{
-									pos:  position{line: 57, col: 60, offset: 1164},
-									name: "KEY_VALUE_LIST",
-								},
+							pos:   position{line: 65, col: 45, offset: 1465},
+							label: "q",
+							expr: &ruleRefExpr{
+								pos:  position{line: 65, col: 48, offset: 1468},
+								name: "IDENT",
 							},
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "PARAMETER_BODY",
-			pos:  position{line: 61, col: 1, offset: 1210},
-			expr: &actionExpr{
-				pos: position{line: 61, col: 19, offset: 1228},
-				run: (*parser).callonPARAMETER_BODY1,
-				expr: &seqExpr{
-					pos: position{line: 61, col: 19, offset: 1228},
-					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 61, col: 19, offset: 1228},
-							val:        "$",
+							pos:        position{line: 65, col: 55, offset: 1475},
+							val:        "/",
 							ignoreCase: false,
-							want:       "\"$\"",
+							want:       "\"/\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 61, col: 23, offset: 1232},
-							label: "t",
+							pos:   position{line: 65, col: 59, offset: 1479},
+							label: "r",
 							expr: &ruleRefExpr{
-								pos:  position{line: 61, col: 26, offset: 1235},
-								name: "IDENT",
+								pos:  position{line: 65, col: 62, offset: 1482},
+								name: "Integer",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 61, col: 33, offset: 1242},
-							label: "fn",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 61, col: 36, offset: 1245},
+							pos:   position{line: 65, col: 71, offset: 1491},
+							label: "a",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 65, col: 74, offset: 1494},
 								expr: &ruleRefExpr{
-									pos:  position{line: 61, col: 37, offset: 1246},
-									name: "APPLY_FN",
+									pos:  position{line: 65, col: 74, offset: 1494},
+									name: "ALIAS",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 61, col: 48, offset: 1257},
+							pos:  position{line: 65, col: 82, offset: 1502},
 							name: "WS",
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 61, col: 51, offset: 1260},
+						&zeroOrMoreExpr{
+							pos: position{line: 65, col: 85, offset: 1505},
 							expr: &ruleRefExpr{
-								pos:  position{line: 61, col: 51, offset: 1260},
+								pos:  position{line: 65, col: 85, offset: 1505},
 								name: "LS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 61, col: 55, offset: 1264},
+							pos:  position{line: 65, col: 89, offset: 1509},
 							name: "WS",
 						},
 					},
@@ -643,174 +847,136 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "KEY_VALUE_LIST",
-			pos:  position{line: 65, col: 1, offset: 1304},
+			name: "BLOCK",
+			pos:  position{line: 69, col: 1, offset: 1549},
 			expr: &actionExpr{
-				pos: position{line: 65, col: 19, offset: 1322},
-				run: (*parser).callonKEY_VALUE_LIST1,
+				pos: position{line: 69, col: 10, offset: 1558},
+				run: (*parser).callonBLOCK1,
 				expr: &seqExpr{
-					pos: position{line: 65, col: 19, offset: 1322},
+					pos: position{line: 69, col: 10, offset: 1558},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 65, col: 19, offset: 1322},
-							label: "first",
+							pos:   position{line: 69, col: 10, offset: 1558},
+							label: "action",
 							expr: &ruleRefExpr{
-								pos:  position{line: 65, col: 25, offset: 1328},
-								name: "KEY_VALUE",
+								pos:  position{line: 69, col: 18, offset: 1566},
+								name: "ACTION_RULE",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 65, col: 35, offset: 1338},
-							label: "others",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 65, col: 42, offset: 1345},
-								expr: &seqExpr{
-									pos: position{line: 65, col: 43, offset: 1346},
-									exprs: []interface{}{
+							pos:   position{line: 69, col: 31, offset: 1579},
+							label: "m",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 69, col: 34, offset: 1582},
+								expr: &ruleRefExpr{
+									pos:  position{line: 69, col: 34, offset: 1582},
+									name: "MODIFIER_RULE",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 69, col: 50, offset: 1598},
+							label: "w",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 69, col: 53, offset: 1601},
+								expr: &ruleRefExpr{
+									pos:  position{line: 69, col: 53, offset: 1601},
+									name: "WITH_RULE",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 69, col: 65, offset: 1613},
+							label: "f",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 69, col: 67, offset: 1615},
+								expr: &choiceExpr{
+									pos: position{line: 69, col: 68, offset: 1616},
+									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 65, col: 43, offset: 1346},
-											name: "WS",
-										},
-										&choiceExpr{
-											pos: position{line: 65, col: 47, offset: 1350},
-											alternatives: []interface{}{
-												&seqExpr{
-													pos: position{line: 65, col: 47, offset: 1350},
-													exprs: []interface{}{
-														&ruleRefExpr{
-															pos:  position{line: 65, col: 47, offset: 1350},
-															name: "LS",
-														},
-														&zeroOrMoreExpr{
-															pos: position{line: 65, col: 50, offset: 1353},
-															expr: &seqExpr{
-																pos: position{line: 65, col: 51, offset: 1354},
-																exprs: []interface{}{
-																	&ruleRefExpr{
-																		pos:  position{line: 65, col: 51, offset: 1354},
-																		name: "WS",
-																	},
-																	&ruleRefExpr{
-																		pos:  position{line: 65, col: 54, offset: 1357},
-																		name: "NL",
-																	},
-																	&ruleRefExpr{
-																		pos:  position{line: 65, col: 57, offset: 1360},
-																		name: "WS",
-																	},
-																},
-															},
-														},
-													},
-												},
-												&ruleRefExpr{
-													pos:  position{line: 65, col: 64, offset: 1367},
-													name: "LS",
-												},
-											},
+											pos:  position{line: 69, col: 68, offset: 1616},
+											name: "HIDDEN_RULE",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 65, col: 68, offset: 1371},
-											name: "WS",
+											pos:  position{line: 69, col: 82, offset: 1630},
+											name: "ONLY_RULE",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 65, col: 71, offset: 1374},
-											name: "KEY_VALUE",
+											pos:  position{line: 69, col: 94, offset: 1642},
+											name: "EXCLUDE_RULE",
 										},
 									},
 								},
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 69, col: 109, offset: 1657},
+							label: "fl",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 69, col: 113, offset: 1661},
+								expr: &ruleRefExpr{
+									pos:  position{line: 69, col: 113, offset: 1661},
+									name: "FLAGS_RULE",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 69, col: 126, offset: 1674},
+							name: "WS",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "KEY_VALUE",
-			pos:  position{line: 69, col: 1, offset: 1430},
+			name: "ACTION_RULE",
+			pos:  position{line: 73, col: 1, offset: 1720},
 			expr: &actionExpr{
-				pos: position{line: 69, col: 14, offset: 1443},
-				run: (*parser).callonKEY_VALUE1,
+				pos: position{line: 73, col: 16, offset: 1735},
+				run: (*parser).callonACTION_RULE1,
 				expr: &seqExpr{
-					pos: position{line: 69, col: 14, offset: 1443},
+					pos: position{line: 73, col: 16, offset: 1735},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 69, col: 14, offset: 1443},
-							label: "k",
+							pos:   position{line: 73, col: 16, offset: 1735},
+							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 69, col: 17, offset: 1446},
-								name: "IDENT_WITH_DOT",
+								pos:  position{line: 73, col: 19, offset: 1738},
+								name: "METHOD",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 69, col: 33, offset: 1462},
-							name: "WS",
-						},
-						&litMatcher{
-							pos:        position{line: 69, col: 36, offset: 1465},
-							val:        "=",
-							ignoreCase: false,
-							want:       "\"=\"",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 69, col: 40, offset: 1469},
-							name: "WS",
+							pos:  position{line: 73, col: 27, offset: 1746},
+							name: "WS_MAND",
 						},
 						&labeledExpr{
-							pos:   position{line: 69, col: 43, offset: 1472},
-							label: "v",
+							pos:   position{line: 73, col: 35, offset: 1754},
+							label: "r",
 							expr: &ruleRefExpr{
-								pos:  position{line: 69, col: 46, offset: 1475},
-								name: "VALUE",
+								pos:  position{line: 73, col: 38, offset: 1757},
+								name: "IDENT",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 69, col: 53, offset: 1482},
-							label: "fn",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 69, col: 56, offset: 1485},
+							pos:   position{line: 73, col: 45, offset: 1764},
+							label: "a",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 73, col: 48, offset: 1767},
 								expr: &ruleRefExpr{
-									pos:  position{line: 69, col: 57, offset: 1486},
-									name: "APPLY_FN",
+									pos:  position{line: 73, col: 48, offset: 1767},
+									name: "ALIAS",
 								},
 							},
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "APPLY_FN",
-			pos:  position{line: 73, col: 1, offset: 1532},
-			expr: &actionExpr{
-				pos: position{line: 73, col: 13, offset: 1544},
-				run: (*parser).callonAPPLY_FN1,
-				expr: &seqExpr{
-					pos: position{line: 73, col: 13, offset: 1544},
-					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 73, col: 13, offset: 1544},
-							name: "WS",
-						},
-						&litMatcher{
-							pos:        position{line: 73, col: 16, offset: 1547},
-							val:        "->",
-							ignoreCase: false,
-							want:       "\"->\"",
-						},
-						&zeroOrOneExpr{
-							pos: position{line: 73, col: 21, offset: 1552},
-							expr: &ruleRefExpr{
-								pos:  position{line: 73, col: 21, offset: 1552},
-								name: "WS",
-							},
-						},
 						&labeledExpr{
-							pos:   position{line: 73, col: 25, offset: 1556},
-							label: "fn",
-							expr: &ruleRefExpr{
-								pos:  position{line: 73, col: 29, offset: 1560},
-								name: "FUNCTION",
+							pos:   position{line: 73, col: 56, offset: 1775},
+							label: "i",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 73, col: 59, offset: 1778},
+								expr: &ruleRefExpr{
+									pos:  position{line: 73, col: 59, offset: 1778},
+									name: "IN",
+								},
 							},
 						},
 					},
@@ -818,112 +984,77 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "FUNCTION",
-			pos:  position{line: 77, col: 1, offset: 1591},
+			name: "METHOD",
+			pos:  position{line: 77, col: 1, offset: 1822},
 			expr: &actionExpr{
-				pos: position{line: 77, col: 13, offset: 1603},
-				run: (*parser).callonFUNCTION1,
+				pos: position{line: 77, col: 11, offset: 1832},
+				run: (*parser).callonMETHOD1,
 				expr: &choiceExpr{
-					pos: position{line: 77, col: 14, offset: 1604},
+					pos: position{line: 77, col: 12, offset: 1833},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 77, col: 14, offset: 1604},
-							val:        "no-multiplex",
-							ignoreCase: false,
-							want:       "\"no-multiplex\"",
-						},
-						&litMatcher{
-							pos:        position{line: 77, col: 31, offset: 1621},
-							val:        "no-explode",
-							ignoreCase: false,
-							want:       "\"no-explode\"",
-						},
-						&litMatcher{
-							pos:        position{line: 77, col: 46, offset: 1636},
-							val:        "base64",
+							pos:        position{line: 77, col: 12, offset: 1833},
+							val:        "from",
 							ignoreCase: false,
-							want:       "\"base64\"",
+							want:       "\"from\"",
 						},
 						&litMatcher{
-							pos:        position{line: 77, col: 57, offset: 1647},
-							val:        "json",
+							pos:        position{line: 77, col: 21, offset: 1842},
+							val:        "to",
 							ignoreCase: false,
-							want:       "\"json\"",
+							want:       "\"to\"",
 						},
 						&litMatcher{
-							pos:        position{line: 77, col: 65, offset: 1655},
-							val:        "as-body",
+							pos:        position{line: 77, col: 28, offset: 1849},
+							val:        "into",
 							ignoreCase: false,
-							want:       "\"as-body\"",
+							want:       "\"into\"",
 						},
 						&litMatcher{
-							pos:        position{line: 77, col: 77, offset: 1667},
-							val:        "as-query",
+							pos:        position{line: 77, col: 36, offset: 1857},
+							val:        "update",
 							ignoreCase: false,
-							want:       "\"as-query\"",
+							want:       "\"update\"",
 						},
 						&litMatcher{
-							pos:        position{line: 77, col: 90, offset: 1680},
-							val:        "flatten",
+							pos:        position{line: 77, col: 47, offset: 1868},
+							val:        "delete",
 							ignoreCase: false,
-							want:       "\"flatten\"",
+							want:       "\"delete\"",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "VALUE",
-			pos:  position{line: 81, col: 1, offset: 1722},
+			name: "ALIAS",
+			pos:  position{line: 81, col: 1, offset: 1909},
 			expr: &actionExpr{
-				pos: position{line: 81, col: 10, offset: 1731},
-				run: (*parser).callonVALUE1,
-				expr: &labeledExpr{
-					pos:   position{line: 81, col: 10, offset: 1731},
-					label: "v",
-					expr: &choiceExpr{
-						pos: position{line: 81, col: 13, offset: 1734},
-						alternatives: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 81, col: 13, offset: 1734},
-								name: "LIST",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 81, col: 20, offset: 1741},
-								name: "OBJECT",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 81, col: 29, offset: 1750},
-								name: "VARIABLE",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 81, col: 40, offset: 1761},
-								name: "PRIMITIVE",
-							},
+				pos: position{line: 81, col: 10, offset: 1918},
+				run: (*parser).callonALIAS1,
+				expr: &seqExpr{
+					pos: position{line: 81, col: 10, offset: 1918},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 81, col: 10, offset: 1918},
+							name: "WS_MAND",
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "LIST",
-			pos:  position{line: 85, col: 1, offset: 1797},
-			expr: &actionExpr{
-				pos: position{line: 85, col: 9, offset: 1805},
-				run: (*parser).callonLIST1,
-				expr: &labeledExpr{
-					pos:   position{line: 85, col: 9, offset: 1805},
-					label: "l",
-					expr: &choiceExpr{
-						pos: position{line: 85, col: 12, offset: 1808},
-						alternatives: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 85, col: 12, offset: 1808},
-								name: "EMPTY_LIST",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 85, col: 25, offset: 1821},
-								name: "POPULATED_LIST",
+						&litMatcher{
+							pos:        position{line: 81, col: 18, offset: 1926},
+							val:        "as",
+							ignoreCase: false,
+							want:       "\"as\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 81, col: 23, offset: 1931},
+							name: "WS_MAND",
+						},
+						&labeledExpr{
+							pos:   position{line: 81, col: 31, offset: 1939},
+							label: "a",
+							expr: &ruleRefExpr{
+								pos:  position{line: 81, col: 34, offset: 1942},
+								name: "IDENT",
 							},
 						},
 					},
@@ -931,125 +1062,213 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "EMPTY_LIST",
-			pos:  position{line: 89, col: 1, offset: 1857},
+			name: "IN",
+			pos:  position{line: 85, col: 1, offset: 1969},
 			expr: &actionExpr{
-				pos: position{line: 89, col: 15, offset: 1871},
-				run: (*parser).callonEMPTY_LIST1,
+				pos: position{line: 85, col: 7, offset: 1975},
+				run: (*parser).callonIN1,
 				expr: &seqExpr{
-					pos: position{line: 89, col: 15, offset: 1871},
+					pos: position{line: 85, col: 7, offset: 1975},
 					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 85, col: 7, offset: 1975},
+							name: "WS_MAND",
+						},
 						&litMatcher{
-							pos:        position{line: 89, col: 15, offset: 1871},
-							val:        "[",
+							pos:        position{line: 85, col: 15, offset: 1983},
+							val:        "in",
 							ignoreCase: false,
-							want:       "\"[\"",
+							want:       "\"in\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 89, col: 19, offset: 1875},
-							name: "WS",
+							pos:  position{line: 85, col: 20, offset: 1988},
+							name: "WS_MAND",
 						},
-						&litMatcher{
-							pos:        position{line: 89, col: 22, offset: 1878},
-							val:        "]",
-							ignoreCase: false,
-							want:       "\"]\"",
+						&labeledExpr{
+							pos:   position{line: 85, col: 28, offset: 1996},
+							label: "t",
+							expr: &ruleRefExpr{
+								pos:  position{line: 85, col: 31, offset: 1999},
+								name: "IDENT_WITH_DOT",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 85, col: 47, offset: 2015},
+							label: "b",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 85, col: 49, offset: 2017},
+								expr: &ruleRefExpr{
+									pos:  position{line: 85, col: 50, offset: 2018},
+									name: "IN_BY",
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "POPULATED_LIST",
-			pos:  position{line: 93, col: 1, offset: 1910},
+			name: "IN_BY",
+			pos:  position{line: 89, col: 1, offset: 2051},
 			expr: &actionExpr{
-				pos: position{line: 93, col: 19, offset: 1928},
-				run: (*parser).callonPOPULATED_LIST1,
+				pos: position{line: 89, col: 10, offset: 2060},
+				run: (*parser).callonIN_BY1,
 				expr: &seqExpr{
-					pos: position{line: 93, col: 19, offset: 1928},
+					pos: position{line: 89, col: 10, offset: 2060},
 					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 89, col: 10, offset: 2060},
+							name: "WS_MAND",
+						},
 						&litMatcher{
-							pos:        position{line: 93, col: 19, offset: 1928},
-							val:        "[",
+							pos:        position{line: 89, col: 18, offset: 2068},
+							val:        "by",
 							ignoreCase: false,
-							want:       "\"[\"",
+							want:       "\"by\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 89, col: 23, offset: 2073},
+							name: "WS_MAND",
+						},
+						&labeledExpr{
+							pos:   position{line: 89, col: 31, offset: 2081},
+							label: "t",
+							expr: &ruleRefExpr{
+								pos:  position{line: 89, col: 34, offset: 2084},
+								name: "IDENT_WITH_DOT",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 89, col: 50, offset: 2100},
+							name: "WS",
+						},
+						&litMatcher{
+							pos:        position{line: 89, col: 53, offset: 2103},
+							val:        "=",
+							ignoreCase: false,
+							want:       "\"=\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 93, col: 23, offset: 1932},
+							pos:  position{line: 89, col: 57, offset: 2107},
 							name: "WS",
 						},
 						&labeledExpr{
-							pos:   position{line: 93, col: 26, offset: 1935},
-							label: "i",
+							pos:   position{line: 89, col: 60, offset: 2110},
+							label: "o",
 							expr: &ruleRefExpr{
-								pos:  position{line: 93, col: 28, offset: 1937},
-								name: "VALUE",
+								pos:  position{line: 89, col: 63, offset: 2113},
+								name: "IDENT_WITH_DOT",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 93, col: 34, offset: 1943},
-							label: "ii",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 93, col: 37, offset: 1946},
+							pos:   position{line: 89, col: 79, offset: 2129},
+							label: "u",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 89, col: 81, offset: 2131},
 								expr: &seqExpr{
-									pos: position{line: 93, col: 38, offset: 1947},
+									pos: position{line: 89, col: 82, offset: 2132},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 93, col: 38, offset: 1947},
-											name: "WS",
+											pos:  position{line: 89, col: 82, offset: 2132},
+											name: "WS_MAND",
 										},
-										&zeroOrMoreExpr{
-											pos: position{line: 93, col: 41, offset: 1950},
-											expr: &ruleRefExpr{
-												pos:  position{line: 93, col: 41, offset: 1950},
-												name: "LS",
-											},
+										&litMatcher{
+											pos:        position{line: 89, col: 90, offset: 2140},
+											val:        "unmatched",
+											ignoreCase: false,
+											want:       "\"unmatched\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 93, col: 45, offset: 1954},
-											name: "WS",
+											pos:  position{line: 89, col: 102, offset: 2152},
+											name: "WS_MAND",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 93, col: 48, offset: 1957},
-											name: "VALUE",
+											pos:  position{line: 89, col: 110, offset: 2160},
+											name: "UNMATCHED_MODE",
 										},
 									},
 								},
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 93, col: 56, offset: 1965},
-							name: "WS",
+					},
+				},
+			},
+		},
+		{
+			name: "UNMATCHED_MODE",
+			pos:  position{line: 93, col: 1, offset: 2217},
+			expr: &actionExpr{
+				pos: position{line: 93, col: 19, offset: 2235},
+				run: (*parser).callonUNMATCHED_MODE1,
+				expr: &choiceExpr{
+					pos: position{line: 93, col: 20, offset: 2236},
+					alternatives: []interface{}{
+						&litMatcher{
+							pos:        position{line: 93, col: 20, offset: 2236},
+							val:        "null",
+							ignoreCase: false,
+							want:       "\"null\"",
 						},
 						&litMatcher{
-							pos:        position{line: 93, col: 59, offset: 1968},
-							val:        "]",
+							pos:        position{line: 93, col: 29, offset: 2245},
+							val:        "drop",
 							ignoreCase: false,
-							want:       "\"]\"",
+							want:       "\"drop\"",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "OBJECT",
-			pos:  position{line: 97, col: 1, offset: 2000},
+			name: "MODIFIER_RULE",
+			pos:  position{line: 97, col: 1, offset: 2284},
 			expr: &actionExpr{
-				pos: position{line: 97, col: 11, offset: 2010},
-				run: (*parser).callonOBJECT1,
+				pos: position{line: 97, col: 18, offset: 2301},
+				run: (*parser).callonMODIFIER_RULE1,
 				expr: &labeledExpr{
-					pos:   position{line: 97, col: 11, offset: 2010},
-					label: "o",
-					expr: &choiceExpr{
-						pos: position{line: 97, col: 14, offset: 2013},
-						alternatives: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 97, col: 14, offset: 2013},
-								name: "EMPTY_OBJ",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 97, col: 26, offset: 2025},
-								name: "POPULATED_OBJ",
+					pos:   position{line: 97, col: 18, offset: 2301},
+					label: "m",
+					expr: &oneOrMoreExpr{
+						pos: position{line: 97, col: 20, offset: 2303},
+						expr: &choiceExpr{
+							pos: position{line: 97, col: 21, offset: 2304},
+							alternatives: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 97, col: 21, offset: 2304},
+									name: "HEADERS",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 97, col: 31, offset: 2314},
+									name: "TIMEOUT",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 97, col: 41, offset: 2324},
+									name: "MAX_AGE",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 97, col: 51, offset: 2334},
+									name: "S_MAX_AGE",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 97, col: 63, offset: 2346},
+									name: "DEPENDS_ON",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 97, col: 76, offset: 2359},
+									name: "WHEN",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 97, col: 83, offset: 2366},
+									name: "PAGINATE",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 97, col: 94, offset: 2377},
+									name: "RETRY",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 97, col: 102, offset: 2385},
+									name: "ON_ERROR",
+								},
 							},
 						},
 					},
@@ -1057,236 +1276,375 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "EMPTY_OBJ",
-			pos:  position{line: 101, col: 1, offset: 2060},
+			name: "WITH_RULE",
+			pos:  position{line: 101, col: 1, offset: 2416},
 			expr: &actionExpr{
-				pos: position{line: 101, col: 14, offset: 2073},
-				run: (*parser).callonEMPTY_OBJ1,
+				pos: position{line: 101, col: 14, offset: 2429},
+				run: (*parser).callonWITH_RULE1,
 				expr: &seqExpr{
-					pos: position{line: 101, col: 14, offset: 2073},
+					pos: position{line: 101, col: 14, offset: 2429},
 					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 101, col: 14, offset: 2429},
+							name: "WS_MAND",
+						},
 						&litMatcher{
-							pos:        position{line: 101, col: 14, offset: 2073},
-							val:        "{",
+							pos:        position{line: 101, col: 22, offset: 2437},
+							val:        "with",
 							ignoreCase: false,
-							want:       "\"{\"",
+							want:       "\"with\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 101, col: 18, offset: 2077},
-							name: "WS",
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 101, col: 21, offset: 2080},
-							expr: &ruleRefExpr{
-								pos:  position{line: 101, col: 21, offset: 2080},
-								name: "NL",
-							},
+							pos:  position{line: 101, col: 29, offset: 2444},
+							name: "WS_MAND",
 						},
-						&ruleRefExpr{
-							pos:  position{line: 101, col: 25, offset: 2084},
-							name: "WS",
+						&labeledExpr{
+							pos:   position{line: 101, col: 37, offset: 2452},
+							label: "pb",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 101, col: 40, offset: 2455},
+								expr: &ruleRefExpr{
+									pos:  position{line: 101, col: 40, offset: 2455},
+									name: "PARAMETER_BODY",
+								},
+							},
 						},
-						&litMatcher{
-							pos:        position{line: 101, col: 28, offset: 2087},
-							val:        "}",
-							ignoreCase: false,
-							want:       "\"}\"",
+						&labeledExpr{
+							pos:   position{line: 101, col: 56, offset: 2471},
+							label: "kvs",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 101, col: 60, offset: 2475},
+								expr: &ruleRefExpr{
+									pos:  position{line: 101, col: 60, offset: 2475},
+									name: "KEY_VALUE_LIST",
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "POPULATED_OBJ",
-			pos:  position{line: 105, col: 1, offset: 2121},
+			name: "PARAMETER_BODY",
+			pos:  position{line: 105, col: 1, offset: 2521},
 			expr: &actionExpr{
-				pos: position{line: 105, col: 18, offset: 2138},
-				run: (*parser).callonPOPULATED_OBJ1,
+				pos: position{line: 105, col: 19, offset: 2539},
+				run: (*parser).callonPARAMETER_BODY1,
 				expr: &seqExpr{
-					pos: position{line: 105, col: 18, offset: 2138},
+					pos: position{line: 105, col: 19, offset: 2539},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 105, col: 18, offset: 2138},
-							val:        "{",
+							pos:        position{line: 105, col: 19, offset: 2539},
+							val:        "$",
 							ignoreCase: false,
-							want:       "\"{\"",
+							want:       "\"$\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 105, col: 23, offset: 2543},
+							label: "t",
+							expr: &ruleRefExpr{
+								pos:  position{line: 105, col: 26, offset: 2546},
+								name: "IDENT",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 105, col: 33, offset: 2553},
+							label: "m",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 105, col: 35, offset: 2555},
+								expr: &ruleRefExpr{
+									pos:  position{line: 105, col: 36, offset: 2556},
+									name: "REQUIRED_MARKER",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 105, col: 54, offset: 2574},
+							label: "fn",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 105, col: 57, offset: 2577},
+								expr: &ruleRefExpr{
+									pos:  position{line: 105, col: 58, offset: 2578},
+									name: "APPLY_FN",
+								},
+							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 105, col: 22, offset: 2142},
+							pos:  position{line: 105, col: 69, offset: 2589},
 							name: "WS",
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 105, col: 25, offset: 2145},
+						&zeroOrOneExpr{
+							pos: position{line: 105, col: 72, offset: 2592},
 							expr: &ruleRefExpr{
-								pos:  position{line: 105, col: 25, offset: 2145},
-								name: "NL",
+								pos:  position{line: 105, col: 72, offset: 2592},
+								name: "LS",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 105, col: 29, offset: 2149},
+							pos:  position{line: 105, col: 76, offset: 2596},
 							name: "WS",
 						},
+					},
+				},
+			},
+		},
+		{
+			name: "KEY_VALUE_LIST",
+			pos:  position{line: 109, col: 1, offset: 2639},
+			expr: &actionExpr{
+				pos: position{line: 109, col: 19, offset: 2657},
+				run: (*parser).callonKEY_VALUE_LIST1,
+				expr: &seqExpr{
+					pos: position{line: 109, col: 19, offset: 2657},
+					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 105, col: 32, offset: 2152},
-							label: "oe",
+							pos:   position{line: 109, col: 19, offset: 2657},
+							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 105, col: 36, offset: 2156},
-								name: "OBJ_ENTRY",
+								pos:  position{line: 109, col: 25, offset: 2663},
+								name: "KEY_VALUE",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 105, col: 47, offset: 2167},
-							label: "oes",
+							pos:   position{line: 109, col: 35, offset: 2673},
+							label: "others",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 105, col: 51, offset: 2171},
+								pos: position{line: 109, col: 42, offset: 2680},
 								expr: &seqExpr{
-									pos: position{line: 105, col: 52, offset: 2172},
+									pos: position{line: 109, col: 43, offset: 2681},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 105, col: 52, offset: 2172},
-											name: "WS",
-										},
-										&litMatcher{
-											pos:        position{line: 105, col: 55, offset: 2175},
-											val:        ",",
-											ignoreCase: false,
-											want:       "\",\"",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 105, col: 59, offset: 2179},
+											pos:  position{line: 109, col: 43, offset: 2681},
 											name: "WS",
 										},
-										&zeroOrMoreExpr{
-											pos: position{line: 105, col: 62, offset: 2182},
-											expr: &ruleRefExpr{
-												pos:  position{line: 105, col: 62, offset: 2182},
-												name: "NL",
+										&choiceExpr{
+											pos: position{line: 109, col: 47, offset: 2685},
+											alternatives: []interface{}{
+												&seqExpr{
+													pos: position{line: 109, col: 47, offset: 2685},
+													exprs: []interface{}{
+														&ruleRefExpr{
+															pos:  position{line: 109, col: 47, offset: 2685},
+															name: "LS",
+														},
+														&zeroOrMoreExpr{
+															pos: position{line: 109, col: 50, offset: 2688},
+															expr: &seqExpr{
+																pos: position{line: 109, col: 51, offset: 2689},
+																exprs: []interface{}{
+																	&ruleRefExpr{
+																		pos:  position{line: 109, col: 51, offset: 2689},
+																		name: "WS",
+																	},
+																	&ruleRefExpr{
+																		pos:  position{line: 109, col: 54, offset: 2692},
+																		name: "NL",
+																	},
+																	&ruleRefExpr{
+																		pos:  position{line: 109, col: 57, offset: 2695},
+																		name: "WS",
+																	},
+																},
+															},
+														},
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 109, col: 64, offset: 2702},
+													name: "LS",
+												},
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 105, col: 66, offset: 2186},
+											pos:  position{line: 109, col: 68, offset: 2706},
 											name: "WS",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 105, col: 69, offset: 2189},
-											name: "OBJ_ENTRY",
+											pos:  position{line: 109, col: 71, offset: 2709},
+											name: "KEY_VALUE",
 										},
 									},
 								},
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 105, col: 81, offset: 2201},
-							name: "WS",
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 105, col: 84, offset: 2204},
-							expr: &ruleRefExpr{
-								pos:  position{line: 105, col: 84, offset: 2204},
-								name: "NL",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 105, col: 88, offset: 2208},
-							name: "WS",
-						},
-						&litMatcher{
-							pos:        position{line: 105, col: 91, offset: 2211},
-							val:        "}",
-							ignoreCase: false,
-							want:       "\"}\"",
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "OBJ_ENTRY",
-			pos:  position{line: 109, col: 1, offset: 2256},
+			name: "KEY_VALUE",
+			pos:  position{line: 113, col: 1, offset: 2765},
 			expr: &actionExpr{
-				pos: position{line: 109, col: 14, offset: 2269},
-				run: (*parser).callonOBJ_ENTRY1,
+				pos: position{line: 113, col: 14, offset: 2778},
+				run: (*parser).callonKEY_VALUE1,
 				expr: &seqExpr{
-					pos: position{line: 109, col: 14, offset: 2269},
+					pos: position{line: 113, col: 14, offset: 2778},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 109, col: 14, offset: 2269},
+							pos:   position{line: 113, col: 14, offset: 2778},
 							label: "k",
-							expr: &choiceExpr{
-								pos: position{line: 109, col: 17, offset: 2272},
-								alternatives: []interface{}{
-									&ruleRefExpr{
-										pos:  position{line: 109, col: 17, offset: 2272},
-										name: "String",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 109, col: 26, offset: 2281},
-										name: "IDENT_WITHOUT_COLLON",
-									},
-								},
+							expr: &ruleRefExpr{
+								pos:  position{line: 113, col: 17, offset: 2781},
+								name: "IDENT_WITH_DOT",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 109, col: 48, offset: 2303},
+							pos:  position{line: 113, col: 33, offset: 2797},
 							name: "WS",
 						},
 						&litMatcher{
-							pos:        position{line: 109, col: 51, offset: 2306},
-							val:        ":",
+							pos:        position{line: 113, col: 36, offset: 2800},
+							val:        "=",
 							ignoreCase: false,
-							want:       "\":\"",
+							want:       "\"=\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 109, col: 55, offset: 2310},
+							pos:  position{line: 113, col: 40, offset: 2804},
 							name: "WS",
 						},
 						&labeledExpr{
-							pos:   position{line: 109, col: 58, offset: 2313},
+							pos:   position{line: 113, col: 43, offset: 2807},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 109, col: 61, offset: 2316},
+								pos:  position{line: 113, col: 46, offset: 2810},
 								name: "VALUE",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 113, col: 53, offset: 2817},
+							label: "fn",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 113, col: 56, offset: 2820},
+								expr: &ruleRefExpr{
+									pos:  position{line: 113, col: 57, offset: 2821},
+									name: "APPLY_FN",
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "PRIMITIVE",
-			pos:  position{line: 113, col: 1, offset: 2357},
+			name: "APPLY_FN",
+			pos:  position{line: 117, col: 1, offset: 2867},
 			expr: &actionExpr{
-				pos: position{line: 113, col: 14, offset: 2370},
-				run: (*parser).callonPRIMITIVE1,
-				expr: &labeledExpr{
-					pos:   position{line: 113, col: 14, offset: 2370},
-					label: "p",
-					expr: &choiceExpr{
-						pos: position{line: 113, col: 17, offset: 2373},
-						alternatives: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 113, col: 17, offset: 2373},
-								name: "Null",
+				pos: position{line: 117, col: 13, offset: 2879},
+				run: (*parser).callonAPPLY_FN1,
+				expr: &seqExpr{
+					pos: position{line: 117, col: 13, offset: 2879},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 117, col: 13, offset: 2879},
+							name: "WS",
+						},
+						&litMatcher{
+							pos:        position{line: 117, col: 16, offset: 2882},
+							val:        "->",
+							ignoreCase: false,
+							want:       "\"->\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 117, col: 21, offset: 2887},
+							expr: &ruleRefExpr{
+								pos:  position{line: 117, col: 21, offset: 2887},
+								name: "WS",
 							},
-							&ruleRefExpr{
-								pos:  position{line: 113, col: 24, offset: 2380},
-								name: "Boolean",
+						},
+						&labeledExpr{
+							pos:   position{line: 117, col: 25, offset: 2891},
+							label: "fn",
+							expr: &ruleRefExpr{
+								pos:  position{line: 117, col: 29, offset: 2895},
+								name: "FUNCTION",
 							},
-							&ruleRefExpr{
-								pos:  position{line: 113, col: 34, offset: 2390},
-								name: "String",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FUNCTION",
+			pos:  position{line: 121, col: 1, offset: 2926},
+			expr: &actionExpr{
+				pos: position{line: 121, col: 13, offset: 2938},
+				run: (*parser).callonFUNCTION1,
+				expr: &seqExpr{
+					pos: position{line: 121, col: 13, offset: 2938},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 121, col: 13, offset: 2938},
+							label: "n",
+							expr: &ruleRefExpr{
+								pos:  position{line: 121, col: 16, offset: 2941},
+								name: "FUNCTION_NAME",
 							},
-							&ruleRefExpr{
-								pos:  position{line: 113, col: 43, offset: 2399},
-								name: "Float",
+						},
+						&labeledExpr{
+							pos:   position{line: 121, col: 31, offset: 2956},
+							label: "a",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 121, col: 33, offset: 2958},
+								expr: &ruleRefExpr{
+									pos:  position{line: 121, col: 34, offset: 2959},
+									name: "FUNCTION_ARGS",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FUNCTION_NAME",
+			pos:  position{line: 125, col: 1, offset: 3006},
+			expr: &actionExpr{
+				pos: position{line: 125, col: 18, offset: 3023},
+				run: (*parser).callonFUNCTION_NAME1,
+				expr: &seqExpr{
+					pos: position{line: 125, col: 18, offset: 3023},
+					exprs: []interface{}{
+						&charClassMatcher{
+							pos:        position{line: 125, col: 18, offset: 3023},
+							val:        "[a-zA-Z]",
+							ranges:     []rune{'a', 'z', 'A', 'Z'},
+							ignoreCase: false,
+							inverted:   false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 125, col: 27, offset: 3032},
+							expr: &charClassMatcher{
+								pos:        position{line: 125, col: 27, offset: 3032},
+								val:        "[a-zA-Z0-9_-]",
+								chars:      []rune{'_', '-'},
+								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+								ignoreCase: false,
+								inverted:   false,
 							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FUNCTION_ARGS",
+			pos:  position{line: 129, col: 1, offset: 3078},
+			expr: &actionExpr{
+				pos: position{line: 129, col: 18, offset: 3095},
+				run: (*parser).callonFUNCTION_ARGS1,
+				expr: &labeledExpr{
+					pos:   position{line: 129, col: 18, offset: 3095},
+					label: "a",
+					expr: &choiceExpr{
+						pos: position{line: 129, col: 21, offset: 3098},
+						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 113, col: 51, offset: 2407},
-								name: "Integer",
+								pos:  position{line: 129, col: 21, offset: 3098},
+								name: "EMPTY_FUNCTION_ARGS",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 113, col: 61, offset: 2417},
-								name: "CHAIN",
+								pos:  position{line: 129, col: 43, offset: 3120},
+								name: "POPULATED_FUNCTION_ARGS",
 							},
 						},
 					},
@@ -1294,118 +1652,1987 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ONLY_RULE",
-			pos:  position{line: 119, col: 1, offset: 2455},
+			name: "EMPTY_FUNCTION_ARGS",
+			pos:  position{line: 133, col: 1, offset: 3165},
 			expr: &actionExpr{
-				pos: position{line: 119, col: 14, offset: 2468},
-				run: (*parser).callonONLY_RULE1,
+				pos: position{line: 133, col: 24, offset: 3188},
+				run: (*parser).callonEMPTY_FUNCTION_ARGS1,
 				expr: &seqExpr{
-					pos: position{line: 119, col: 14, offset: 2468},
+					pos: position{line: 133, col: 24, offset: 3188},
 					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 119, col: 14, offset: 2468},
-							name: "WS_MAND",
-						},
 						&litMatcher{
-							pos:        position{line: 119, col: 22, offset: 2476},
-							val:        "only",
+							pos:        position{line: 133, col: 24, offset: 3188},
+							val:        "(",
 							ignoreCase: false,
-							want:       "\"only\"",
+							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 119, col: 29, offset: 2483},
-							name: "WS_MAND",
+							pos:  position{line: 133, col: 28, offset: 3192},
+							name: "WS",
 						},
-						&labeledExpr{
-							pos:   position{line: 119, col: 37, offset: 2491},
-							label: "f",
-							expr: &ruleRefExpr{
-								pos:  position{line: 119, col: 40, offset: 2494},
-								name: "FILTER",
-							},
+						&litMatcher{
+							pos:        position{line: 133, col: 31, offset: 3195},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
 						},
-						&labeledExpr{
-							pos:   position{line: 119, col: 48, offset: 2502},
-							label: "fs",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 119, col: 51, offset: 2505},
-								expr: &seqExpr{
-									pos: position{line: 119, col: 52, offset: 2506},
-									exprs: []interface{}{
+					},
+				},
+			},
+		},
+		{
+			name: "POPULATED_FUNCTION_ARGS",
+			pos:  position{line: 137, col: 1, offset: 3227},
+			expr: &actionExpr{
+				pos: position{line: 137, col: 28, offset: 3254},
+				run: (*parser).callonPOPULATED_FUNCTION_ARGS1,
+				expr: &seqExpr{
+					pos: position{line: 137, col: 28, offset: 3254},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 137, col: 28, offset: 3254},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 137, col: 32, offset: 3258},
+							name: "WS",
+						},
+						&labeledExpr{
+							pos:   position{line: 137, col: 35, offset: 3261},
+							label: "a",
+							expr: &ruleRefExpr{
+								pos:  position{line: 137, col: 38, offset: 3264},
+								name: "VALUE",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 137, col: 45, offset: 3271},
+							label: "as",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 137, col: 48, offset: 3274},
+								expr: &seqExpr{
+									pos: position{line: 137, col: 49, offset: 3275},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 137, col: 49, offset: 3275},
+											name: "WS",
+										},
+										&litMatcher{
+											pos:        position{line: 137, col: 52, offset: 3278},
+											val:        ",",
+											ignoreCase: false,
+											want:       "\",\"",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 137, col: 56, offset: 3282},
+											name: "WS",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 137, col: 59, offset: 3285},
+											name: "VALUE",
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 137, col: 67, offset: 3293},
+							name: "WS",
+						},
+						&litMatcher{
+							pos:        position{line: 137, col: 70, offset: 3296},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "VALUE",
+			pos:  position{line: 141, col: 1, offset: 3336},
+			expr: &actionExpr{
+				pos: position{line: 141, col: 10, offset: 3345},
+				run: (*parser).callonVALUE1,
+				expr: &labeledExpr{
+					pos:   position{line: 141, col: 10, offset: 3345},
+					label: "v",
+					expr: &choiceExpr{
+						pos: position{line: 141, col: 13, offset: 3348},
+						alternatives: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 141, col: 13, offset: 3348},
+								name: "LIST",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 141, col: 20, offset: 3355},
+								name: "OBJECT",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 141, col: 29, offset: 3364},
+								name: "VARIABLE_VALUE",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 141, col: 46, offset: 3381},
+								name: "PRIMITIVE",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "VARIABLE_VALUE",
+			pos:  position{line: 145, col: 1, offset: 3417},
+			expr: &actionExpr{
+				pos: position{line: 145, col: 19, offset: 3435},
+				run: (*parser).callonVARIABLE_VALUE1,
+				expr: &seqExpr{
+					pos: position{line: 145, col: 19, offset: 3435},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 145, col: 19, offset: 3435},
+							label: "v",
+							expr: &ruleRefExpr{
+								pos:  position{line: 145, col: 22, offset: 3438},
+								name: "VARIABLE",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 145, col: 32, offset: 3448},
+							label: "m",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 145, col: 34, offset: 3450},
+								expr: &choiceExpr{
+									pos: position{line: 145, col: 35, offset: 3451},
+									alternatives: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 145, col: 35, offset: 3451},
+											name: "REQUIRED_MARKER",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 145, col: 53, offset: 3469},
+											name: "DEFAULT_VALUE",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "REQUIRED_MARKER",
+			pos:  position{line: 149, col: 1, offset: 3521},
+			expr: &actionExpr{
+				pos: position{line: 149, col: 20, offset: 3540},
+				run: (*parser).callonREQUIRED_MARKER1,
+				expr: &litMatcher{
+					pos:        position{line: 149, col: 20, offset: 3540},
+					val:        "!",
+					ignoreCase: false,
+					want:       "\"!\"",
+				},
+			},
+		},
+		{
+			name: "DEFAULT_VALUE",
+			pos:  position{line: 153, col: 1, offset: 3577},
+			expr: &actionExpr{
+				pos: position{line: 153, col: 18, offset: 3594},
+				run: (*parser).callonDEFAULT_VALUE1,
+				expr: &seqExpr{
+					pos: position{line: 153, col: 18, offset: 3594},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 153, col: 18, offset: 3594},
+							name: "WS",
+						},
+						&litMatcher{
+							pos:        position{line: 153, col: 21, offset: 3597},
+							val:        "?:",
+							ignoreCase: false,
+							want:       "\"?:\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 153, col: 26, offset: 3602},
+							name: "WS",
+						},
+						&labeledExpr{
+							pos:   position{line: 153, col: 29, offset: 3605},
+							label: "d",
+							expr: &ruleRefExpr{
+								pos:  position{line: 153, col: 32, offset: 3608},
+								name: "VALUE",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LIST",
+			pos:  position{line: 157, col: 1, offset: 3647},
+			expr: &actionExpr{
+				pos: position{line: 157, col: 9, offset: 3655},
+				run: (*parser).callonLIST1,
+				expr: &labeledExpr{
+					pos:   position{line: 157, col: 9, offset: 3655},
+					label: "l",
+					expr: &choiceExpr{
+						pos: position{line: 157, col: 12, offset: 3658},
+						alternatives: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 157, col: 12, offset: 3658},
+								name: "EMPTY_LIST",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 157, col: 25, offset: 3671},
+								name: "POPULATED_LIST",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EMPTY_LIST",
+			pos:  position{line: 161, col: 1, offset: 3707},
+			expr: &actionExpr{
+				pos: position{line: 161, col: 15, offset: 3721},
+				run: (*parser).callonEMPTY_LIST1,
+				expr: &seqExpr{
+					pos: position{line: 161, col: 15, offset: 3721},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 161, col: 15, offset: 3721},
+							val:        "[",
+							ignoreCase: false,
+							want:       "\"[\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 161, col: 19, offset: 3725},
+							name: "WS",
+						},
+						&litMatcher{
+							pos:        position{line: 161, col: 22, offset: 3728},
+							val:        "]",
+							ignoreCase: false,
+							want:       "\"]\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "POPULATED_LIST",
+			pos:  position{line: 165, col: 1, offset: 3760},
+			expr: &actionExpr{
+				pos: position{line: 165, col: 19, offset: 3778},
+				run: (*parser).callonPOPULATED_LIST1,
+				expr: &seqExpr{
+					pos: position{line: 165, col: 19, offset: 3778},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 165, col: 19, offset: 3778},
+							val:        "[",
+							ignoreCase: false,
+							want:       "\"[\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 165, col: 23, offset: 3782},
+							name: "WS",
+						},
+						&labeledExpr{
+							pos:   position{line: 165, col: 26, offset: 3785},
+							label: "i",
+							expr: &ruleRefExpr{
+								pos:  position{line: 165, col: 28, offset: 3787},
+								name: "VALUE",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 165, col: 34, offset: 3793},
+							label: "ii",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 165, col: 37, offset: 3796},
+								expr: &seqExpr{
+									pos: position{line: 165, col: 38, offset: 3797},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 165, col: 38, offset: 3797},
+											name: "WS",
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 165, col: 41, offset: 3800},
+											expr: &ruleRefExpr{
+												pos:  position{line: 165, col: 41, offset: 3800},
+												name: "LS",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 165, col: 45, offset: 3804},
+											name: "WS",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 165, col: 48, offset: 3807},
+											name: "VALUE",
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 165, col: 56, offset: 3815},
+							name: "WS",
+						},
+						&litMatcher{
+							pos:        position{line: 165, col: 59, offset: 3818},
+							val:        "]",
+							ignoreCase: false,
+							want:       "\"]\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "OBJECT",
+			pos:  position{line: 169, col: 1, offset: 3850},
+			expr: &actionExpr{
+				pos: position{line: 169, col: 11, offset: 3860},
+				run: (*parser).callonOBJECT1,
+				expr: &labeledExpr{
+					pos:   position{line: 169, col: 11, offset: 3860},
+					label: "o",
+					expr: &choiceExpr{
+						pos: position{line: 169, col: 14, offset: 3863},
+						alternatives: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 169, col: 14, offset: 3863},
+								name: "EMPTY_OBJ",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 169, col: 26, offset: 3875},
+								name: "POPULATED_OBJ",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EMPTY_OBJ",
+			pos:  position{line: 173, col: 1, offset: 3910},
+			expr: &actionExpr{
+				pos: position{line: 173, col: 14, offset: 3923},
+				run: (*parser).callonEMPTY_OBJ1,
+				expr: &seqExpr{
+					pos: position{line: 173, col: 14, offset: 3923},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 173, col: 14, offset: 3923},
+							val:        "{",
+							ignoreCase: false,
+							want:       "\"{\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 173, col: 18, offset: 3927},
+							name: "WS",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 173, col: 21, offset: 3930},
+							expr: &ruleRefExpr{
+								pos:  position{line: 173, col: 21, offset: 3930},
+								name: "NL",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 173, col: 25, offset: 3934},
+							name: "WS",
+						},
+						&litMatcher{
+							pos:        position{line: 173, col: 28, offset: 3937},
+							val:        "}",
+							ignoreCase: false,
+							want:       "\"}\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "POPULATED_OBJ",
+			pos:  position{line: 177, col: 1, offset: 3971},
+			expr: &actionExpr{
+				pos: position{line: 177, col: 18, offset: 3988},
+				run: (*parser).callonPOPULATED_OBJ1,
+				expr: &seqExpr{
+					pos: position{line: 177, col: 18, offset: 3988},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 177, col: 18, offset: 3988},
+							val:        "{",
+							ignoreCase: false,
+							want:       "\"{\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 177, col: 22, offset: 3992},
+							name: "WS",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 177, col: 25, offset: 3995},
+							expr: &ruleRefExpr{
+								pos:  position{line: 177, col: 25, offset: 3995},
+								name: "NL",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 177, col: 29, offset: 3999},
+							name: "WS",
+						},
+						&labeledExpr{
+							pos:   position{line: 177, col: 32, offset: 4002},
+							label: "oe",
+							expr: &ruleRefExpr{
+								pos:  position{line: 177, col: 36, offset: 4006},
+								name: "OBJ_ENTRY",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 177, col: 47, offset: 4017},
+							label: "oes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 177, col: 51, offset: 4021},
+								expr: &seqExpr{
+									pos: position{line: 177, col: 52, offset: 4022},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 177, col: 52, offset: 4022},
+											name: "WS",
+										},
+										&litMatcher{
+											pos:        position{line: 177, col: 55, offset: 4025},
+											val:        ",",
+											ignoreCase: false,
+											want:       "\",\"",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 177, col: 59, offset: 4029},
+											name: "WS",
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 177, col: 62, offset: 4032},
+											expr: &ruleRefExpr{
+												pos:  position{line: 177, col: 62, offset: 4032},
+												name: "NL",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 177, col: 66, offset: 4036},
+											name: "WS",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 177, col: 69, offset: 4039},
+											name: "OBJ_ENTRY",
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 177, col: 81, offset: 4051},
+							name: "WS",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 177, col: 84, offset: 4054},
+							expr: &ruleRefExpr{
+								pos:  position{line: 177, col: 84, offset: 4054},
+								name: "NL",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 177, col: 88, offset: 4058},
+							name: "WS",
+						},
+						&litMatcher{
+							pos:        position{line: 177, col: 91, offset: 4061},
+							val:        "}",
+							ignoreCase: false,
+							want:       "\"}\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "OBJ_ENTRY",
+			pos:  position{line: 181, col: 1, offset: 4106},
+			expr: &actionExpr{
+				pos: position{line: 181, col: 14, offset: 4119},
+				run: (*parser).callonOBJ_ENTRY1,
+				expr: &seqExpr{
+					pos: position{line: 181, col: 14, offset: 4119},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 181, col: 14, offset: 4119},
+							label: "k",
+							expr: &choiceExpr{
+								pos: position{line: 181, col: 17, offset: 4122},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 181, col: 17, offset: 4122},
+										name: "String",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 181, col: 26, offset: 4131},
+										name: "IDENT_WITHOUT_COLLON",
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 181, col: 48, offset: 4153},
+							name: "WS",
+						},
+						&litMatcher{
+							pos:        position{line: 181, col: 51, offset: 4156},
+							val:        ":",
+							ignoreCase: false,
+							want:       "\":\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 181, col: 55, offset: 4160},
+							name: "WS",
+						},
+						&labeledExpr{
+							pos:   position{line: 181, col: 58, offset: 4163},
+							label: "v",
+							expr: &ruleRefExpr{
+								pos:  position{line: 181, col: 61, offset: 4166},
+								name: "VALUE",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PRIMITIVE",
+			pos:  position{line: 185, col: 1, offset: 4207},
+			expr: &actionExpr{
+				pos: position{line: 185, col: 14, offset: 4220},
+				run: (*parser).callonPRIMITIVE1,
+				expr: &labeledExpr{
+					pos:   position{line: 185, col: 14, offset: 4220},
+					label: "p",
+					expr: &choiceExpr{
+						pos: position{line: 185, col: 17, offset: 4223},
+						alternatives: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 185, col: 17, offset: 4223},
+								name: "Null",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 185, col: 24, offset: 4230},
+								name: "Boolean",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 185, col: 34, offset: 4240},
+								name: "TEMPLATE",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 185, col: 45, offset: 4251},
+								name: "String",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 185, col: 54, offset: 4260},
+								name: "Float",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 185, col: 62, offset: 4268},
+								name: "Integer",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 185, col: 72, offset: 4278},
+								name: "CHAIN",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ONLY_RULE",
+			pos:  position{line: 191, col: 1, offset: 4316},
+			expr: &actionExpr{
+				pos: position{line: 191, col: 14, offset: 4329},
+				run: (*parser).callonONLY_RULE1,
+				expr: &seqExpr{
+					pos: position{line: 191, col: 14, offset: 4329},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 191, col: 14, offset: 4329},
+							name: "WS_MAND",
+						},
+						&litMatcher{
+							pos:        position{line: 191, col: 22, offset: 4337},
+							val:        "only",
+							ignoreCase: false,
+							want:       "\"only\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 191, col: 29, offset: 4344},
+							name: "WS_MAND",
+						},
+						&labeledExpr{
+							pos:   position{line: 191, col: 37, offset: 4352},
+							label: "f",
+							expr: &ruleRefExpr{
+								pos:  position{line: 191, col: 40, offset: 4355},
+								name: "FILTER",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 191, col: 48, offset: 4363},
+							label: "fs",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 191, col: 51, offset: 4366},
+								expr: &seqExpr{
+									pos: position{line: 191, col: 52, offset: 4367},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 191, col: 52, offset: 4367},
+											name: "WS",
+										},
+										&notExpr{
+											pos: position{line: 191, col: 55, offset: 4370},
+											expr: &choiceExpr{
+												pos: position{line: 191, col: 57, offset: 4372},
+												alternatives: []interface{}{
+													&ruleRefExpr{
+														pos:  position{line: 191, col: 57, offset: 4372},
+														name: "FLAGS_RULE",
+													},
+													&seqExpr{
+														pos: position{line: 191, col: 70, offset: 4385},
+														exprs: []interface{}{
+															&ruleRefExpr{
+																pos:  position{line: 191, col: 70, offset: 4385},
+																name: "BS",
+															},
+															&ruleRefExpr{
+																pos:  position{line: 191, col: 73, offset: 4388},
+																name: "BLOCK",
+															},
+														},
+													},
+												},
+											},
+										},
+										&choiceExpr{
+											pos: position{line: 191, col: 81, offset: 4396},
+											alternatives: []interface{}{
+												&seqExpr{
+													pos: position{line: 191, col: 81, offset: 4396},
+													exprs: []interface{}{
+														&ruleRefExpr{
+															pos:  position{line: 191, col: 81, offset: 4396},
+															name: "LS",
+														},
+														&zeroOrMoreExpr{
+															pos: position{line: 191, col: 84, offset: 4399},
+															expr: &seqExpr{
+																pos: position{line: 191, col: 85, offset: 4400},
+																exprs: []interface{}{
+																	&ruleRefExpr{
+																		pos:  position{line: 191, col: 85, offset: 4400},
+																		name: "WS",
+																	},
+																	&ruleRefExpr{
+																		pos:  position{line: 191, col: 88, offset: 4403},
+																		name: "NL",
+																	},
+																	&ruleRefExpr{
+																		pos:  position{line: 191, col: 91, offset: 4406},
+																		name: "WS",
+																	},
+																},
+															},
+														},
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 191, col: 98, offset: 4413},
+													name: "LS",
+												},
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 191, col: 102, offset: 4417},
+											name: "WS",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 191, col: 105, offset: 4420},
+											name: "FILTER",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EXCLUDE_RULE",
+			pos:  position{line: 195, col: 1, offset: 4457},
+			expr: &actionExpr{
+				pos: position{line: 195, col: 17, offset: 4473},
+				run: (*parser).callonEXCLUDE_RULE1,
+				expr: &seqExpr{
+					pos: position{line: 195, col: 17, offset: 4473},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 195, col: 17, offset: 4473},
+							name: "WS_MAND",
+						},
+						&litMatcher{
+							pos:        position{line: 195, col: 25, offset: 4481},
+							val:        "exclude",
+							ignoreCase: false,
+							want:       "\"exclude\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 195, col: 35, offset: 4491},
+							name: "WS_MAND",
+						},
+						&labeledExpr{
+							pos:   position{line: 195, col: 43, offset: 4499},
+							label: "p",
+							expr: &ruleRefExpr{
+								pos:  position{line: 195, col: 46, offset: 4502},
+								name: "EXCLUDE_PATH",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 195, col: 60, offset: 4516},
+							label: "ps",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 195, col: 63, offset: 4519},
+								expr: &seqExpr{
+									pos: position{line: 195, col: 64, offset: 4520},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 195, col: 64, offset: 4520},
+											name: "WS",
+										},
+										&notExpr{
+											pos: position{line: 195, col: 67, offset: 4523},
+											expr: &choiceExpr{
+												pos: position{line: 195, col: 69, offset: 4525},
+												alternatives: []interface{}{
+													&ruleRefExpr{
+														pos:  position{line: 195, col: 69, offset: 4525},
+														name: "FLAGS_RULE",
+													},
+													&seqExpr{
+														pos: position{line: 195, col: 82, offset: 4538},
+														exprs: []interface{}{
+															&ruleRefExpr{
+																pos:  position{line: 195, col: 82, offset: 4538},
+																name: "BS",
+															},
+															&ruleRefExpr{
+																pos:  position{line: 195, col: 85, offset: 4541},
+																name: "BLOCK",
+															},
+														},
+													},
+												},
+											},
+										},
+										&choiceExpr{
+											pos: position{line: 195, col: 93, offset: 4549},
+											alternatives: []interface{}{
+												&seqExpr{
+													pos: position{line: 195, col: 93, offset: 4549},
+													exprs: []interface{}{
+														&ruleRefExpr{
+															pos:  position{line: 195, col: 93, offset: 4549},
+															name: "LS",
+														},
+														&zeroOrMoreExpr{
+															pos: position{line: 195, col: 96, offset: 4552},
+															expr: &seqExpr{
+																pos: position{line: 195, col: 97, offset: 4553},
+																exprs: []interface{}{
+																	&ruleRefExpr{
+																		pos:  position{line: 195, col: 97, offset: 4553},
+																		name: "WS",
+																	},
+																	&ruleRefExpr{
+																		pos:  position{line: 195, col: 100, offset: 4556},
+																		name: "NL",
+																	},
+																	&ruleRefExpr{
+																		pos:  position{line: 195, col: 103, offset: 4559},
+																		name: "WS",
+																	},
+																},
+															},
+														},
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 195, col: 110, offset: 4566},
+													name: "LS",
+												},
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 195, col: 114, offset: 4570},
+											name: "WS",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 195, col: 117, offset: 4573},
+											name: "EXCLUDE_PATH",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EXCLUDE_PATH",
+			pos:  position{line: 199, col: 1, offset: 4619},
+			expr: &actionExpr{
+				pos: position{line: 199, col: 17, offset: 4635},
+				run: (*parser).callonEXCLUDE_PATH1,
+				expr: &labeledExpr{
+					pos:   position{line: 199, col: 17, offset: 4635},
+					label: "p",
+					expr: &ruleRefExpr{
+						pos:  position{line: 199, col: 20, offset: 4638},
+						name: "IDENT_WITH_DOT",
+					},
+				},
+			},
+		},
+		{
+			name: "FILTER",
+			pos:  position{line: 203, col: 1, offset: 4685},
+			expr: &actionExpr{
+				pos: position{line: 203, col: 11, offset: 4695},
+				run: (*parser).callonFILTER1,
+				expr: &seqExpr{
+					pos: position{line: 203, col: 11, offset: 4695},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 203, col: 11, offset: 4695},
+							label: "f",
+							expr: &ruleRefExpr{
+								pos:  position{line: 203, col: 14, offset: 4698},
+								name: "FILTER_VALUE",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 203, col: 28, offset: 4712},
+							label: "fns",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 203, col: 32, offset: 4716},
+								expr: &ruleRefExpr{
+									pos:  position{line: 203, col: 33, offset: 4717},
+									name: "APPLY_FILTER_FN",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 203, col: 51, offset: 4735},
+							label: "a",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 203, col: 53, offset: 4737},
+								expr: &ruleRefExpr{
+									pos:  position{line: 203, col: 54, offset: 4738},
+									name: "FILTER_ALIAS",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FILTER_ALIAS",
+			pos:  position{line: 207, col: 1, offset: 4787},
+			expr: &actionExpr{
+				pos: position{line: 207, col: 17, offset: 4803},
+				run: (*parser).callonFILTER_ALIAS1,
+				expr: &seqExpr{
+					pos: position{line: 207, col: 17, offset: 4803},
+					exprs: []interface{}{
+						&oneOrMoreExpr{
+							pos: position{line: 207, col: 17, offset: 4803},
+							expr: &ruleRefExpr{
+								pos:  position{line: 207, col: 17, offset: 4803},
+								name: "SPACE",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 207, col: 24, offset: 4810},
+							val:        "as",
+							ignoreCase: false,
+							want:       "\"as\"",
+						},
+						&oneOrMoreExpr{
+							pos: position{line: 207, col: 29, offset: 4815},
+							expr: &ruleRefExpr{
+								pos:  position{line: 207, col: 29, offset: 4815},
+								name: "SPACE",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 207, col: 36, offset: 4822},
+							label: "a",
+							expr: &ruleRefExpr{
+								pos:  position{line: 207, col: 39, offset: 4825},
+								name: "IDENT",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FILTER_VALUE",
+			pos:  position{line: 211, col: 1, offset: 4852},
+			expr: &actionExpr{
+				pos: position{line: 211, col: 17, offset: 4868},
+				run: (*parser).callonFILTER_VALUE1,
+				expr: &labeledExpr{
+					pos:   position{line: 211, col: 17, offset: 4868},
+					label: "fv",
+					expr: &choiceExpr{
+						pos: position{line: 211, col: 21, offset: 4872},
+						alternatives: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 211, col: 21, offset: 4872},
+								name: "IDENT_WITH_DOT",
+							},
+							&litMatcher{
+								pos:        position{line: 211, col: 38, offset: 4889},
+								val:        "*",
+								ignoreCase: false,
+								want:       "\"*\"",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "APPLY_FILTER_FN",
+			pos:  position{line: 215, col: 1, offset: 4926},
+			expr: &actionExpr{
+				pos: position{line: 215, col: 20, offset: 4945},
+				run: (*parser).callonAPPLY_FILTER_FN1,
+				expr: &seqExpr{
+					pos: position{line: 215, col: 20, offset: 4945},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 215, col: 20, offset: 4945},
+							name: "WS",
+						},
+						&litMatcher{
+							pos:        position{line: 215, col: 23, offset: 4948},
+							val:        "->",
+							ignoreCase: false,
+							want:       "\"->\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 215, col: 28, offset: 4953},
+							expr: &ruleRefExpr{
+								pos:  position{line: 215, col: 28, offset: 4953},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 215, col: 32, offset: 4957},
+							label: "fn",
+							expr: &ruleRefExpr{
+								pos:  position{line: 215, col: 36, offset: 4961},
+								name: "FILTER_FUNCTION",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FILTER_FUNCTION",
+			pos:  position{line: 219, col: 1, offset: 4999},
+			expr: &actionExpr{
+				pos: position{line: 219, col: 20, offset: 5018},
+				run: (*parser).callonFILTER_FUNCTION1,
+				expr: &labeledExpr{
+					pos:   position{line: 219, col: 20, offset: 5018},
+					label: "f",
+					expr: &choiceExpr{
+						pos: position{line: 219, col: 23, offset: 5021},
+						alternatives: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 219, col: 23, offset: 5021},
+								name: "MATCHES",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 219, col: 33, offset: 5031},
+								name: "FILTER_BY_REGEX",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 219, col: 51, offset: 5049},
+								name: "SORT_BY",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 219, col: 61, offset: 5059},
+								name: "TAKE",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 219, col: 68, offset: 5066},
+								name: "SKIP",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 219, col: 75, offset: 5073},
+								name: "DISTINCT",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 219, col: 86, offset: 5084},
+								name: "INDEX_BY",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 219, col: 97, offset: 5095},
+								name: "GROUP_BY",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 219, col: 108, offset: 5106},
+								name: "FUNCTION",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MATCHES",
+			pos:  position{line: 223, col: 1, offset: 5136},
+			expr: &actionExpr{
+				pos: position{line: 223, col: 12, offset: 5147},
+				run: (*parser).callonMATCHES1,
+				expr: &seqExpr{
+					pos: position{line: 223, col: 12, offset: 5147},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 223, col: 12, offset: 5147},
+							val:        "matches",
+							ignoreCase: false,
+							want:       "\"matches\"",
+						},
+						&litMatcher{
+							pos:        position{line: 223, col: 22, offset: 5157},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 223, col: 26, offset: 5161},
+							label: "arg",
+							expr: &choiceExpr{
+								pos: position{line: 223, col: 31, offset: 5166},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 223, col: 31, offset: 5166},
+										name: "VARIABLE_VALUE",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 223, col: 48, offset: 5183},
+										name: "String",
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 223, col: 56, offset: 5191},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FILTER_BY_REGEX",
+			pos:  position{line: 227, col: 1, offset: 5228},
+			expr: &actionExpr{
+				pos: position{line: 227, col: 20, offset: 5247},
+				run: (*parser).callonFILTER_BY_REGEX1,
+				expr: &seqExpr{
+					pos: position{line: 227, col: 20, offset: 5247},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 227, col: 20, offset: 5247},
+							val:        "filterByRegex",
+							ignoreCase: false,
+							want:       "\"filterByRegex\"",
+						},
+						&litMatcher{
+							pos:        position{line: 227, col: 36, offset: 5263},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 227, col: 40, offset: 5267},
+							expr: &ruleRefExpr{
+								pos:  position{line: 227, col: 40, offset: 5267},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 227, col: 44, offset: 5271},
+							label: "path",
+							expr: &choiceExpr{
+								pos: position{line: 227, col: 50, offset: 5277},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 227, col: 50, offset: 5277},
+										name: "VARIABLE_VALUE",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 227, col: 67, offset: 5294},
+										name: "String",
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 227, col: 75, offset: 5302},
+							expr: &ruleRefExpr{
+								pos:  position{line: 227, col: 75, offset: 5302},
+								name: "WS",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 227, col: 79, offset: 5306},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 227, col: 83, offset: 5310},
+							expr: &ruleRefExpr{
+								pos:  position{line: 227, col: 83, offset: 5310},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 227, col: 87, offset: 5314},
+							label: "regex",
+							expr: &choiceExpr{
+								pos: position{line: 227, col: 94, offset: 5321},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 227, col: 94, offset: 5321},
+										name: "VARIABLE_VALUE",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 227, col: 111, offset: 5338},
+										name: "String",
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 227, col: 119, offset: 5346},
+							expr: &ruleRefExpr{
+								pos:  position{line: 227, col: 119, offset: 5346},
+								name: "WS",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 227, col: 124, offset: 5351},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SORT_BY",
+			pos:  position{line: 231, col: 1, offset: 5398},
+			expr: &actionExpr{
+				pos: position{line: 231, col: 12, offset: 5409},
+				run: (*parser).callonSORT_BY1,
+				expr: &seqExpr{
+					pos: position{line: 231, col: 12, offset: 5409},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 231, col: 12, offset: 5409},
+							val:        "sort-by",
+							ignoreCase: false,
+							want:       "\"sort-by\"",
+						},
+						&litMatcher{
+							pos:        position{line: 231, col: 22, offset: 5419},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 231, col: 26, offset: 5423},
+							expr: &ruleRefExpr{
+								pos:  position{line: 231, col: 26, offset: 5423},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 231, col: 30, offset: 5427},
+							label: "path",
+							expr: &choiceExpr{
+								pos: position{line: 231, col: 36, offset: 5433},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 231, col: 36, offset: 5433},
+										name: "VARIABLE_VALUE",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 231, col: 53, offset: 5450},
+										name: "String",
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 231, col: 61, offset: 5458},
+							label: "order",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 231, col: 67, offset: 5464},
+								expr: &seqExpr{
+									pos: position{line: 231, col: 68, offset: 5465},
+									exprs: []interface{}{
+										&zeroOrOneExpr{
+											pos: position{line: 231, col: 68, offset: 5465},
+											expr: &ruleRefExpr{
+												pos:  position{line: 231, col: 68, offset: 5465},
+												name: "WS",
+											},
+										},
+										&litMatcher{
+											pos:        position{line: 231, col: 72, offset: 5469},
+											val:        ",",
+											ignoreCase: false,
+											want:       "\",\"",
+										},
+										&zeroOrOneExpr{
+											pos: position{line: 231, col: 76, offset: 5473},
+											expr: &ruleRefExpr{
+												pos:  position{line: 231, col: 76, offset: 5473},
+												name: "WS",
+											},
+										},
+										&choiceExpr{
+											pos: position{line: 231, col: 81, offset: 5478},
+											alternatives: []interface{}{
+												&ruleRefExpr{
+													pos:  position{line: 231, col: 81, offset: 5478},
+													name: "VARIABLE_VALUE",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 231, col: 98, offset: 5495},
+													name: "String",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 231, col: 108, offset: 5505},
+							expr: &ruleRefExpr{
+								pos:  position{line: 231, col: 108, offset: 5505},
+								name: "WS",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 231, col: 112, offset: 5509},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TAKE",
+			pos:  position{line: 235, col: 1, offset: 5549},
+			expr: &actionExpr{
+				pos: position{line: 235, col: 9, offset: 5557},
+				run: (*parser).callonTAKE1,
+				expr: &seqExpr{
+					pos: position{line: 235, col: 9, offset: 5557},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 235, col: 9, offset: 5557},
+							val:        "take",
+							ignoreCase: false,
+							want:       "\"take\"",
+						},
+						&litMatcher{
+							pos:        position{line: 235, col: 16, offset: 5564},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 235, col: 20, offset: 5568},
+							expr: &ruleRefExpr{
+								pos:  position{line: 235, col: 20, offset: 5568},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 235, col: 24, offset: 5572},
+							label: "n",
+							expr: &choiceExpr{
+								pos: position{line: 235, col: 27, offset: 5575},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 235, col: 27, offset: 5575},
+										name: "VARIABLE_VALUE",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 235, col: 44, offset: 5592},
+										name: "Integer",
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 235, col: 53, offset: 5601},
+							expr: &ruleRefExpr{
+								pos:  position{line: 235, col: 53, offset: 5601},
+								name: "WS",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 235, col: 57, offset: 5605},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SKIP",
+			pos:  position{line: 239, col: 1, offset: 5633},
+			expr: &actionExpr{
+				pos: position{line: 239, col: 9, offset: 5641},
+				run: (*parser).callonSKIP1,
+				expr: &seqExpr{
+					pos: position{line: 239, col: 9, offset: 5641},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 239, col: 9, offset: 5641},
+							val:        "skip",
+							ignoreCase: false,
+							want:       "\"skip\"",
+						},
+						&litMatcher{
+							pos:        position{line: 239, col: 16, offset: 5648},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 239, col: 20, offset: 5652},
+							expr: &ruleRefExpr{
+								pos:  position{line: 239, col: 20, offset: 5652},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 239, col: 24, offset: 5656},
+							label: "n",
+							expr: &choiceExpr{
+								pos: position{line: 239, col: 27, offset: 5659},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 239, col: 27, offset: 5659},
+										name: "VARIABLE_VALUE",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 239, col: 44, offset: 5676},
+										name: "Integer",
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 239, col: 53, offset: 5685},
+							expr: &ruleRefExpr{
+								pos:  position{line: 239, col: 53, offset: 5685},
+								name: "WS",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 239, col: 57, offset: 5689},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "DISTINCT",
+			pos:  position{line: 243, col: 1, offset: 5717},
+			expr: &actionExpr{
+				pos: position{line: 243, col: 13, offset: 5729},
+				run: (*parser).callonDISTINCT1,
+				expr: &seqExpr{
+					pos: position{line: 243, col: 13, offset: 5729},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 243, col: 13, offset: 5729},
+							val:        "distinct",
+							ignoreCase: false,
+							want:       "\"distinct\"",
+						},
+						&litMatcher{
+							pos:        position{line: 243, col: 24, offset: 5740},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 243, col: 28, offset: 5744},
+							expr: &ruleRefExpr{
+								pos:  position{line: 243, col: 28, offset: 5744},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 243, col: 32, offset: 5748},
+							label: "path",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 243, col: 37, offset: 5753},
+								expr: &choiceExpr{
+									pos: position{line: 243, col: 38, offset: 5754},
+									alternatives: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 243, col: 38, offset: 5754},
+											name: "VARIABLE_VALUE",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 243, col: 55, offset: 5771},
+											name: "String",
+										},
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 243, col: 64, offset: 5780},
+							expr: &ruleRefExpr{
+								pos:  position{line: 243, col: 64, offset: 5780},
+								name: "WS",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 243, col: 68, offset: 5784},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "INDEX_BY",
+			pos:  position{line: 247, col: 1, offset: 5819},
+			expr: &actionExpr{
+				pos: position{line: 247, col: 13, offset: 5831},
+				run: (*parser).callonINDEX_BY1,
+				expr: &seqExpr{
+					pos: position{line: 247, col: 13, offset: 5831},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 247, col: 13, offset: 5831},
+							val:        "index-by",
+							ignoreCase: false,
+							want:       "\"index-by\"",
+						},
+						&litMatcher{
+							pos:        position{line: 247, col: 24, offset: 5842},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 247, col: 28, offset: 5846},
+							expr: &ruleRefExpr{
+								pos:  position{line: 247, col: 28, offset: 5846},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 247, col: 32, offset: 5850},
+							label: "path",
+							expr: &choiceExpr{
+								pos: position{line: 247, col: 38, offset: 5856},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 247, col: 38, offset: 5856},
+										name: "VARIABLE_VALUE",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 247, col: 55, offset: 5873},
+										name: "String",
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 247, col: 63, offset: 5881},
+							expr: &ruleRefExpr{
+								pos:  position{line: 247, col: 63, offset: 5881},
+								name: "WS",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 247, col: 67, offset: 5885},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "GROUP_BY",
+			pos:  position{line: 251, col: 1, offset: 5919},
+			expr: &actionExpr{
+				pos: position{line: 251, col: 13, offset: 5931},
+				run: (*parser).callonGROUP_BY1,
+				expr: &seqExpr{
+					pos: position{line: 251, col: 13, offset: 5931},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 251, col: 13, offset: 5931},
+							val:        "group-by",
+							ignoreCase: false,
+							want:       "\"group-by\"",
+						},
+						&litMatcher{
+							pos:        position{line: 251, col: 24, offset: 5942},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 251, col: 28, offset: 5946},
+							expr: &ruleRefExpr{
+								pos:  position{line: 251, col: 28, offset: 5946},
+								name: "WS",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 251, col: 32, offset: 5950},
+							label: "path",
+							expr: &choiceExpr{
+								pos: position{line: 251, col: 38, offset: 5956},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 251, col: 38, offset: 5956},
+										name: "VARIABLE_VALUE",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 251, col: 55, offset: 5973},
+										name: "String",
+									},
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 251, col: 63, offset: 5981},
+							expr: &ruleRefExpr{
+								pos:  position{line: 251, col: 63, offset: 5981},
+								name: "WS",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 251, col: 67, offset: 5985},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "HEADERS",
+			pos:  position{line: 255, col: 1, offset: 6019},
+			expr: &actionExpr{
+				pos: position{line: 255, col: 12, offset: 6030},
+				run: (*parser).callonHEADERS1,
+				expr: &seqExpr{
+					pos: position{line: 255, col: 12, offset: 6030},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 255, col: 12, offset: 6030},
+							name: "WS_MAND",
+						},
+						&litMatcher{
+							pos:        position{line: 255, col: 20, offset: 6038},
+							val:        "headers",
+							ignoreCase: false,
+							want:       "\"headers\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 255, col: 30, offset: 6048},
+							name: "WS_MAND",
+						},
+						&labeledExpr{
+							pos:   position{line: 255, col: 38, offset: 6056},
+							label: "h",
+							expr: &ruleRefExpr{
+								pos:  position{line: 255, col: 41, offset: 6059},
+								name: "HEADER",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 255, col: 49, offset: 6067},
+							label: "hs",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 255, col: 52, offset: 6070},
+								expr: &seqExpr{
+									pos: position{line: 255, col: 53, offset: 6071},
+									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 119, col: 52, offset: 2506},
+											pos:  position{line: 255, col: 53, offset: 6071},
 											name: "WS",
 										},
-										&notExpr{
-											pos: position{line: 119, col: 55, offset: 2509},
-											expr: &choiceExpr{
-												pos: position{line: 119, col: 57, offset: 2511},
-												alternatives: []interface{}{
-													&ruleRefExpr{
-														pos:  position{line: 119, col: 57, offset: 2511},
-														name: "FLAGS_RULE",
-													},
-													&seqExpr{
-														pos: position{line: 119, col: 70, offset: 2524},
-														exprs: []interface{}{
-															&ruleRefExpr{
-																pos:  position{line: 119, col: 70, offset: 2524},
-																name: "BS",
-															},
-															&ruleRefExpr{
-																pos:  position{line: 119, col: 73, offset: 2527},
-																name: "BLOCK",
-															},
-														},
-													},
-												},
-											},
+										&ruleRefExpr{
+											pos:  position{line: 255, col: 56, offset: 6074},
+											name: "LS",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 255, col: 59, offset: 6077},
+											name: "WS",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 255, col: 62, offset: 6080},
+											name: "HEADER",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "HEADER",
+			pos:  position{line: 259, col: 1, offset: 6120},
+			expr: &actionExpr{
+				pos: position{line: 259, col: 11, offset: 6130},
+				run: (*parser).callonHEADER1,
+				expr: &seqExpr{
+					pos: position{line: 259, col: 11, offset: 6130},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 259, col: 11, offset: 6130},
+							label: "n",
+							expr: &ruleRefExpr{
+								pos:  position{line: 259, col: 14, offset: 6133},
+								name: "IDENT",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 259, col: 21, offset: 6140},
+							name: "WS",
+						},
+						&litMatcher{
+							pos:        position{line: 259, col: 24, offset: 6143},
+							val:        "=",
+							ignoreCase: false,
+							want:       "\"=\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 259, col: 28, offset: 6147},
+							name: "WS",
+						},
+						&labeledExpr{
+							pos:   position{line: 259, col: 31, offset: 6150},
+							label: "v",
+							expr: &choiceExpr{
+								pos: position{line: 259, col: 34, offset: 6153},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 259, col: 34, offset: 6153},
+										name: "VARIABLE_VALUE",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 259, col: 51, offset: 6170},
+										name: "CHAIN",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 259, col: 59, offset: 6178},
+										name: "TEMPLATE",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 259, col: 70, offset: 6189},
+										name: "String",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "HIDDEN_RULE",
+			pos:  position{line: 263, col: 1, offset: 6226},
+			expr: &actionExpr{
+				pos: position{line: 263, col: 16, offset: 6241},
+				run: (*parser).callonHIDDEN_RULE1,
+				expr: &seqExpr{
+					pos: position{line: 263, col: 16, offset: 6241},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 263, col: 16, offset: 6241},
+							name: "WS_MAND",
+						},
+						&litMatcher{
+							pos:        position{line: 263, col: 24, offset: 6249},
+							val:        "hidden",
+							ignoreCase: false,
+							want:       "\"hidden\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "PAGINATE",
+			pos:  position{line: 267, col: 1, offset: 6283},
+			expr: &actionExpr{
+				pos: position{line: 267, col: 13, offset: 6295},
+				run: (*parser).callonPAGINATE1,
+				expr: &seqExpr{
+					pos: position{line: 267, col: 13, offset: 6295},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 267, col: 13, offset: 6295},
+							name: "WS_MAND",
+						},
+						&litMatcher{
+							pos:        position{line: 267, col: 21, offset: 6303},
+							val:        "paginate",
+							ignoreCase: false,
+							want:       "\"paginate\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 267, col: 32, offset: 6314},
+							name: "WS_MAND",
+						},
+						&labeledExpr{
+							pos:   position{line: 267, col: 40, offset: 6322},
+							label: "p",
+							expr: &ruleRefExpr{
+								pos:  position{line: 267, col: 43, offset: 6325},
+								name: "IDENT",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 267, col: 50, offset: 6332},
+							name: "WS",
+						},
+						&litMatcher{
+							pos:        position{line: 267, col: 53, offset: 6335},
+							val:        "=",
+							ignoreCase: false,
+							want:       "\"=\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 267, col: 57, offset: 6339},
+							name: "WS",
+						},
+						&labeledExpr{
+							pos:   position{line: 267, col: 60, offset: 6342},
+							label: "path",
+							expr: &ruleRefExpr{
+								pos:  position{line: 267, col: 66, offset: 6348},
+								name: "IDENT_WITH_DOT",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 267, col: 82, offset: 6364},
+							label: "m",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 267, col: 84, offset: 6366},
+								expr: &seqExpr{
+									pos: position{line: 267, col: 85, offset: 6367},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 267, col: 85, offset: 6367},
+											name: "WS_MAND",
+										},
+										&litMatcher{
+											pos:        position{line: 267, col: 93, offset: 6375},
+											val:        "max",
+											ignoreCase: false,
+											want:       "\"max\"",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 267, col: 99, offset: 6381},
+											name: "WS_MAND",
 										},
 										&choiceExpr{
-											pos: position{line: 119, col: 81, offset: 2535},
+											pos: position{line: 267, col: 108, offset: 6390},
 											alternatives: []interface{}{
-												&seqExpr{
-													pos: position{line: 119, col: 81, offset: 2535},
-													exprs: []interface{}{
-														&ruleRefExpr{
-															pos:  position{line: 119, col: 81, offset: 2535},
-															name: "LS",
-														},
-														&zeroOrMoreExpr{
-															pos: position{line: 119, col: 84, offset: 2538},
-															expr: &seqExpr{
-																pos: position{line: 119, col: 85, offset: 2539},
-																exprs: []interface{}{
-																	&ruleRefExpr{
-																		pos:  position{line: 119, col: 85, offset: 2539},
-																		name: "WS",
-																	},
-																	&ruleRefExpr{
-																		pos:  position{line: 119, col: 88, offset: 2542},
-																		name: "NL",
-																	},
-																	&ruleRefExpr{
-																		pos:  position{line: 119, col: 91, offset: 2545},
-																		name: "WS",
-																	},
-																},
-															},
-														},
-													},
+												&ruleRefExpr{
+													pos:  position{line: 267, col: 108, offset: 6390},
+													name: "VARIABLE",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 267, col: 119, offset: 6401},
+													name: "Integer",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "RETRY",
+			pos:  position{line: 271, col: 1, offset: 6449},
+			expr: &actionExpr{
+				pos: position{line: 271, col: 10, offset: 6458},
+				run: (*parser).callonRETRY1,
+				expr: &seqExpr{
+					pos: position{line: 271, col: 10, offset: 6458},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 271, col: 10, offset: 6458},
+							name: "WS_MAND",
+						},
+						&litMatcher{
+							pos:        position{line: 271, col: 18, offset: 6466},
+							val:        "retry",
+							ignoreCase: false,
+							want:       "\"retry\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 271, col: 26, offset: 6474},
+							name: "WS_MAND",
+						},
+						&labeledExpr{
+							pos:   position{line: 271, col: 34, offset: 6482},
+							label: "a",
+							expr: &choiceExpr{
+								pos: position{line: 271, col: 37, offset: 6485},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 271, col: 37, offset: 6485},
+										name: "VARIABLE",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 271, col: 48, offset: 6496},
+										name: "Integer",
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 271, col: 57, offset: 6505},
+							label: "b",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 271, col: 59, offset: 6507},
+								expr: &seqExpr{
+									pos: position{line: 271, col: 60, offset: 6508},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 271, col: 60, offset: 6508},
+											name: "WS_MAND",
+										},
+										&litMatcher{
+											pos:        position{line: 271, col: 68, offset: 6516},
+											val:        "backoff",
+											ignoreCase: false,
+											want:       "\"backoff\"",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 271, col: 78, offset: 6526},
+											name: "WS_MAND",
+										},
+										&choiceExpr{
+											pos: position{line: 271, col: 87, offset: 6535},
+											alternatives: []interface{}{
+												&ruleRefExpr{
+													pos:  position{line: 271, col: 87, offset: 6535},
+													name: "VARIABLE",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 119, col: 98, offset: 2552},
-													name: "LS",
+													pos:  position{line: 271, col: 98, offset: 6546},
+													name: "Integer",
 												},
 											},
 										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 271, col: 109, offset: 6557},
+							label: "u",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 271, col: 111, offset: 6559},
+								expr: &seqExpr{
+									pos: position{line: 271, col: 112, offset: 6560},
+									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 119, col: 102, offset: 2556},
-											name: "WS",
+											pos:  position{line: 271, col: 112, offset: 6560},
+											name: "WS_MAND",
 										},
-										&ruleRefExpr{
-											pos:  position{line: 119, col: 105, offset: 2559},
-											name: "FILTER",
+										&litMatcher{
+											pos:        position{line: 271, col: 120, offset: 6568},
+											val:        "unsafe",
+											ignoreCase: false,
+											want:       "\"unsafe\"",
 										},
 									},
 								},
@@ -1416,58 +3643,44 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "FILTER",
-			pos:  position{line: 123, col: 1, offset: 2596},
+			name: "ON_ERROR",
+			pos:  position{line: 275, col: 1, offset: 6610},
 			expr: &actionExpr{
-				pos: position{line: 123, col: 11, offset: 2606},
-				run: (*parser).callonFILTER1,
+				pos: position{line: 275, col: 13, offset: 6622},
+				run: (*parser).callonON_ERROR1,
 				expr: &seqExpr{
-					pos: position{line: 123, col: 11, offset: 2606},
+					pos: position{line: 275, col: 13, offset: 6622},
 					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 123, col: 11, offset: 2606},
-							label: "f",
-							expr: &ruleRefExpr{
-								pos:  position{line: 123, col: 14, offset: 2609},
-								name: "FILTER_VALUE",
-							},
+						&ruleRefExpr{
+							pos:  position{line: 275, col: 13, offset: 6622},
+							name: "WS_MAND",
 						},
-						&labeledExpr{
-							pos:   position{line: 123, col: 28, offset: 2623},
-							label: "fns",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 123, col: 32, offset: 2627},
-								expr: &ruleRefExpr{
-									pos:  position{line: 123, col: 33, offset: 2628},
-									name: "APPLY_FILTER_FN",
-								},
-							},
+						&litMatcher{
+							pos:        position{line: 275, col: 21, offset: 6630},
+							val:        "on-error",
+							ignoreCase: false,
+							want:       "\"on-error\"",
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "FILTER_VALUE",
-			pos:  position{line: 127, col: 1, offset: 2677},
-			expr: &actionExpr{
-				pos: position{line: 127, col: 17, offset: 2693},
-				run: (*parser).callonFILTER_VALUE1,
-				expr: &labeledExpr{
-					pos:   position{line: 127, col: 17, offset: 2693},
-					label: "fv",
-					expr: &choiceExpr{
-						pos: position{line: 127, col: 21, offset: 2697},
-						alternatives: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 127, col: 21, offset: 2697},
-								name: "IDENT_WITH_DOT",
-							},
-							&litMatcher{
-								pos:        position{line: 127, col: 38, offset: 2714},
-								val:        "*",
-								ignoreCase: false,
-								want:       "\"*\"",
+						&ruleRefExpr{
+							pos:  position{line: 275, col: 32, offset: 6641},
+							name: "WS_MAND",
+						},
+						&litMatcher{
+							pos:        position{line: 275, col: 40, offset: 6649},
+							val:        "default",
+							ignoreCase: false,
+							want:       "\"default\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 275, col: 50, offset: 6659},
+							name: "WS_MAND",
+						},
+						&labeledExpr{
+							pos:   position{line: 275, col: 58, offset: 6667},
+							label: "v",
+							expr: &ruleRefExpr{
+								pos:  position{line: 275, col: 61, offset: 6670},
+								name: "VALUE",
 							},
 						},
 					},
@@ -1475,62 +3688,43 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "APPLY_FILTER_FN",
-			pos:  position{line: 131, col: 1, offset: 2751},
+			name: "TIMEOUT",
+			pos:  position{line: 279, col: 1, offset: 6704},
 			expr: &actionExpr{
-				pos: position{line: 131, col: 20, offset: 2770},
-				run: (*parser).callonAPPLY_FILTER_FN1,
+				pos: position{line: 279, col: 12, offset: 6715},
+				run: (*parser).callonTIMEOUT1,
 				expr: &seqExpr{
-					pos: position{line: 131, col: 20, offset: 2770},
+					pos: position{line: 279, col: 12, offset: 6715},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 131, col: 20, offset: 2770},
-							name: "WS",
+							pos:  position{line: 279, col: 12, offset: 6715},
+							name: "WS_MAND",
 						},
 						&litMatcher{
-							pos:        position{line: 131, col: 23, offset: 2773},
-							val:        "->",
+							pos:        position{line: 279, col: 20, offset: 6723},
+							val:        "timeout",
 							ignoreCase: false,
-							want:       "\"->\"",
+							want:       "\"timeout\"",
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 131, col: 28, offset: 2778},
-							expr: &ruleRefExpr{
-								pos:  position{line: 131, col: 28, offset: 2778},
-								name: "WS",
-							},
+						&ruleRefExpr{
+							pos:  position{line: 279, col: 30, offset: 6733},
+							name: "WS_MAND",
 						},
 						&labeledExpr{
-							pos:   position{line: 131, col: 32, offset: 2782},
-							label: "fn",
-							expr: &ruleRefExpr{
-								pos:  position{line: 131, col: 36, offset: 2786},
-								name: "FILTER_FUNCTION",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "FILTER_FUNCTION",
-			pos:  position{line: 135, col: 1, offset: 2824},
-			expr: &actionExpr{
-				pos: position{line: 135, col: 20, offset: 2843},
-				run: (*parser).callonFILTER_FUNCTION1,
-				expr: &labeledExpr{
-					pos:   position{line: 135, col: 20, offset: 2843},
-					label: "f",
-					expr: &choiceExpr{
-						pos: position{line: 135, col: 23, offset: 2846},
-						alternatives: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 135, col: 23, offset: 2846},
-								name: "MATCHES",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 135, col: 33, offset: 2856},
-								name: "FILTER_BY_REGEX",
+							pos:   position{line: 279, col: 38, offset: 6741},
+							label: "t",
+							expr: &choiceExpr{
+								pos: position{line: 279, col: 41, offset: 6744},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 279, col: 41, offset: 6744},
+										name: "VARIABLE",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 279, col: 52, offset: 6755},
+										name: "Integer",
+									},
+								},
 							},
 						},
 					},
@@ -1538,265 +3732,248 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "MATCHES",
-			pos:  position{line: 139, col: 1, offset: 2893},
+			name: "MAX_AGE",
+			pos:  position{line: 283, col: 1, offset: 6791},
 			expr: &actionExpr{
-				pos: position{line: 139, col: 12, offset: 2904},
-				run: (*parser).callonMATCHES1,
+				pos: position{line: 283, col: 12, offset: 6802},
+				run: (*parser).callonMAX_AGE1,
 				expr: &seqExpr{
-					pos: position{line: 139, col: 12, offset: 2904},
+					pos: position{line: 283, col: 12, offset: 6802},
 					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 139, col: 12, offset: 2904},
-							val:        "matches",
-							ignoreCase: false,
-							want:       "\"matches\"",
+						&ruleRefExpr{
+							pos:  position{line: 283, col: 12, offset: 6802},
+							name: "WS_MAND",
 						},
 						&litMatcher{
-							pos:        position{line: 139, col: 22, offset: 2914},
-							val:        "(",
+							pos:        position{line: 283, col: 20, offset: 6810},
+							val:        "max-age",
 							ignoreCase: false,
-							want:       "\"(\"",
+							want:       "\"max-age\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 283, col: 30, offset: 6820},
+							name: "WS_MAND",
 						},
 						&labeledExpr{
-							pos:   position{line: 139, col: 26, offset: 2918},
-							label: "arg",
+							pos:   position{line: 283, col: 38, offset: 6828},
+							label: "t",
 							expr: &choiceExpr{
-								pos: position{line: 139, col: 31, offset: 2923},
+								pos: position{line: 283, col: 41, offset: 6831},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 139, col: 31, offset: 2923},
+										pos:  position{line: 283, col: 41, offset: 6831},
 										name: "VARIABLE",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 139, col: 42, offset: 2934},
-										name: "String",
+										pos:  position{line: 283, col: 52, offset: 6842},
+										name: "Integer",
 									},
 								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 139, col: 50, offset: 2942},
-							val:        ")",
-							ignoreCase: false,
-							want:       "\")\"",
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "FILTER_BY_REGEX",
-			pos:  position{line: 143, col: 1, offset: 2979},
+			name: "S_MAX_AGE",
+			pos:  position{line: 287, col: 1, offset: 6877},
 			expr: &actionExpr{
-				pos: position{line: 143, col: 20, offset: 2998},
-				run: (*parser).callonFILTER_BY_REGEX1,
+				pos: position{line: 287, col: 14, offset: 6890},
+				run: (*parser).callonS_MAX_AGE1,
 				expr: &seqExpr{
-					pos: position{line: 143, col: 20, offset: 2998},
+					pos: position{line: 287, col: 14, offset: 6890},
 					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 143, col: 20, offset: 2998},
-							val:        "filterByRegex",
-							ignoreCase: false,
-							want:       "\"filterByRegex\"",
+						&ruleRefExpr{
+							pos:  position{line: 287, col: 14, offset: 6890},
+							name: "WS_MAND",
 						},
 						&litMatcher{
-							pos:        position{line: 143, col: 36, offset: 3014},
-							val:        "(",
+							pos:        position{line: 287, col: 22, offset: 6898},
+							val:        "s-max-age",
 							ignoreCase: false,
-							want:       "\"(\"",
+							want:       "\"s-max-age\"",
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 143, col: 40, offset: 3018},
-							expr: &ruleRefExpr{
-								pos:  position{line: 143, col: 40, offset: 3018},
-								name: "WS",
-							},
+						&ruleRefExpr{
+							pos:  position{line: 287, col: 34, offset: 6910},
+							name: "WS_MAND",
 						},
 						&labeledExpr{
-							pos:   position{line: 143, col: 44, offset: 3022},
-							label: "path",
+							pos:   position{line: 287, col: 42, offset: 6918},
+							label: "t",
 							expr: &choiceExpr{
-								pos: position{line: 143, col: 50, offset: 3028},
+								pos: position{line: 287, col: 45, offset: 6921},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 143, col: 50, offset: 3028},
+										pos:  position{line: 287, col: 45, offset: 6921},
 										name: "VARIABLE",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 143, col: 61, offset: 3039},
-										name: "String",
+										pos:  position{line: 287, col: 56, offset: 6932},
+										name: "Integer",
 									},
 								},
 							},
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 143, col: 69, offset: 3047},
-							expr: &ruleRefExpr{
-								pos:  position{line: 143, col: 69, offset: 3047},
-								name: "WS",
-							},
+					},
+				},
+			},
+		},
+		{
+			name: "DEPENDS_ON",
+			pos:  position{line: 292, col: 1, offset: 6969},
+			expr: &actionExpr{
+				pos: position{line: 292, col: 15, offset: 6983},
+				run: (*parser).callonDEPENDS_ON1,
+				expr: &seqExpr{
+					pos: position{line: 292, col: 15, offset: 6983},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 292, col: 15, offset: 6983},
+							name: "WS_MAND",
 						},
 						&litMatcher{
-							pos:        position{line: 143, col: 73, offset: 3051},
-							val:        ",",
+							pos:        position{line: 292, col: 23, offset: 6991},
+							val:        "depends-on",
 							ignoreCase: false,
-							want:       "\",\"",
+							want:       "\"depends-on\"",
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 143, col: 77, offset: 3055},
-							expr: &ruleRefExpr{
-								pos:  position{line: 143, col: 77, offset: 3055},
-								name: "WS",
-							},
+						&ruleRefExpr{
+							pos:  position{line: 292, col: 36, offset: 7004},
+							name: "WS_MAND",
 						},
 						&labeledExpr{
-							pos:   position{line: 143, col: 81, offset: 3059},
-							label: "regex",
+							pos:   position{line: 292, col: 44, offset: 7012},
+							label: "t",
 							expr: &choiceExpr{
-								pos: position{line: 143, col: 88, offset: 3066},
+								pos: position{line: 292, col: 47, offset: 7015},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 143, col: 88, offset: 3066},
-										name: "VARIABLE",
+										pos:  position{line: 292, col: 47, offset: 7015},
+										name: "DEPENDS_ON_TARGETS",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 143, col: 99, offset: 3077},
-										name: "String",
+										pos:  position{line: 292, col: 68, offset: 7036},
+										name: "IDENT",
 									},
 								},
 							},
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 143, col: 107, offset: 3085},
-							expr: &ruleRefExpr{
-								pos:  position{line: 143, col: 107, offset: 3085},
-								name: "WS",
+						&labeledExpr{
+							pos:   position{line: 292, col: 75, offset: 7043},
+							label: "cond",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 292, col: 80, offset: 7048},
+								expr: &ruleRefExpr{
+									pos:  position{line: 292, col: 81, offset: 7049},
+									name: "DEPENDS_ON_CONDITION",
+								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 143, col: 112, offset: 3090},
-							val:        ")",
-							ignoreCase: false,
-							want:       "\")\"",
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "HEADERS",
-			pos:  position{line: 147, col: 1, offset: 3137},
+			name: "DEPENDS_ON_TARGETS",
+			pos:  position{line: 296, col: 1, offset: 7107},
 			expr: &actionExpr{
-				pos: position{line: 147, col: 12, offset: 3148},
-				run: (*parser).callonHEADERS1,
+				pos: position{line: 296, col: 23, offset: 7129},
+				run: (*parser).callonDEPENDS_ON_TARGETS1,
 				expr: &seqExpr{
-					pos: position{line: 147, col: 12, offset: 3148},
+					pos: position{line: 296, col: 23, offset: 7129},
 					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 147, col: 12, offset: 3148},
-							name: "WS_MAND",
-						},
 						&litMatcher{
-							pos:        position{line: 147, col: 20, offset: 3156},
-							val:        "headers",
+							pos:        position{line: 296, col: 23, offset: 7129},
+							val:        "[",
 							ignoreCase: false,
-							want:       "\"headers\"",
+							want:       "\"[\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 147, col: 30, offset: 3166},
-							name: "WS_MAND",
+							pos:  position{line: 296, col: 27, offset: 7133},
+							name: "WS",
 						},
 						&labeledExpr{
-							pos:   position{line: 147, col: 38, offset: 3174},
-							label: "h",
+							pos:   position{line: 296, col: 30, offset: 7136},
+							label: "t",
 							expr: &ruleRefExpr{
-								pos:  position{line: 147, col: 41, offset: 3177},
-								name: "HEADER",
+								pos:  position{line: 296, col: 33, offset: 7139},
+								name: "IDENT",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 147, col: 49, offset: 3185},
-							label: "hs",
+							pos:   position{line: 296, col: 40, offset: 7146},
+							label: "ts",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 147, col: 52, offset: 3188},
+								pos: position{line: 296, col: 43, offset: 7149},
 								expr: &seqExpr{
-									pos: position{line: 147, col: 53, offset: 3189},
+									pos: position{line: 296, col: 44, offset: 7150},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 147, col: 53, offset: 3189},
+											pos:  position{line: 296, col: 44, offset: 7150},
 											name: "WS",
 										},
-										&ruleRefExpr{
-											pos:  position{line: 147, col: 56, offset: 3192},
-											name: "LS",
+										&litMatcher{
+											pos:        position{line: 296, col: 47, offset: 7153},
+											val:        ",",
+											ignoreCase: false,
+											want:       "\",\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 147, col: 59, offset: 3195},
+											pos:  position{line: 296, col: 51, offset: 7157},
 											name: "WS",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 147, col: 62, offset: 3198},
-											name: "HEADER",
+											pos:  position{line: 296, col: 54, offset: 7160},
+											name: "IDENT",
 										},
 									},
 								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 296, col: 62, offset: 7168},
+							name: "WS",
+						},
+						&litMatcher{
+							pos:        position{line: 296, col: 65, offset: 7171},
+							val:        "]",
+							ignoreCase: false,
+							want:       "\"]\"",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "HEADER",
-			pos:  position{line: 151, col: 1, offset: 3238},
+			name: "DEPENDS_ON_CONDITION",
+			pos:  position{line: 300, col: 1, offset: 7215},
 			expr: &actionExpr{
-				pos: position{line: 151, col: 11, offset: 3248},
-				run: (*parser).callonHEADER1,
+				pos: position{line: 300, col: 25, offset: 7239},
+				run: (*parser).callonDEPENDS_ON_CONDITION1,
 				expr: &seqExpr{
-					pos: position{line: 151, col: 11, offset: 3248},
+					pos: position{line: 300, col: 25, offset: 7239},
 					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 151, col: 11, offset: 3248},
-							label: "n",
-							expr: &ruleRefExpr{
-								pos:  position{line: 151, col: 14, offset: 3251},
-								name: "IDENT",
-							},
-						},
 						&ruleRefExpr{
-							pos:  position{line: 151, col: 21, offset: 3258},
-							name: "WS",
+							pos:  position{line: 300, col: 25, offset: 7239},
+							name: "WS_MAND",
 						},
 						&litMatcher{
-							pos:        position{line: 151, col: 24, offset: 3261},
-							val:        "=",
+							pos:        position{line: 300, col: 33, offset: 7247},
+							val:        "on",
 							ignoreCase: false,
-							want:       "\"=\"",
+							want:       "\"on\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 151, col: 28, offset: 3265},
-							name: "WS",
+							pos:  position{line: 300, col: 38, offset: 7252},
+							name: "WS_MAND",
 						},
 						&labeledExpr{
-							pos:   position{line: 151, col: 31, offset: 3268},
+							pos:   position{line: 300, col: 46, offset: 7260},
 							label: "v",
-							expr: &choiceExpr{
-								pos: position{line: 151, col: 34, offset: 3271},
-								alternatives: []interface{}{
-									&ruleRefExpr{
-										pos:  position{line: 151, col: 34, offset: 3271},
-										name: "VARIABLE",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 151, col: 45, offset: 3282},
-										name: "CHAIN",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 151, col: 53, offset: 3290},
-										name: "String",
-									},
-								},
+							expr: &ruleRefExpr{
+								pos:  position{line: 300, col: 49, offset: 7263},
+								name: "DEPENDS_ON_CONDITION_VALUE",
 							},
 						},
 					},
@@ -1804,64 +3981,85 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "HIDDEN_RULE",
-			pos:  position{line: 155, col: 1, offset: 3327},
+			name: "DEPENDS_ON_CONDITION_VALUE",
+			pos:  position{line: 304, col: 1, offset: 7311},
 			expr: &actionExpr{
-				pos: position{line: 155, col: 16, offset: 3342},
-				run: (*parser).callonHIDDEN_RULE1,
-				expr: &seqExpr{
-					pos: position{line: 155, col: 16, offset: 3342},
-					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 155, col: 16, offset: 3342},
-							name: "WS_MAND",
+				pos: position{line: 304, col: 31, offset: 7341},
+				run: (*parser).callonDEPENDS_ON_CONDITION_VALUE1,
+				expr: &choiceExpr{
+					pos: position{line: 304, col: 32, offset: 7342},
+					alternatives: []interface{}{
+						&litMatcher{
+							pos:        position{line: 304, col: 32, offset: 7342},
+							val:        "success",
+							ignoreCase: false,
+							want:       "\"success\"",
 						},
 						&litMatcher{
-							pos:        position{line: 155, col: 24, offset: 3350},
-							val:        "hidden",
+							pos:        position{line: 304, col: 44, offset: 7354},
+							val:        "failure",
 							ignoreCase: false,
-							want:       "\"hidden\"",
+							want:       "\"failure\"",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "TIMEOUT",
-			pos:  position{line: 159, col: 1, offset: 3384},
+			name: "WHEN",
+			pos:  position{line: 308, col: 1, offset: 7396},
 			expr: &actionExpr{
-				pos: position{line: 159, col: 12, offset: 3395},
-				run: (*parser).callonTIMEOUT1,
+				pos: position{line: 308, col: 9, offset: 7404},
+				run: (*parser).callonWHEN1,
 				expr: &seqExpr{
-					pos: position{line: 159, col: 12, offset: 3395},
+					pos: position{line: 308, col: 9, offset: 7404},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 159, col: 12, offset: 3395},
+							pos:  position{line: 308, col: 9, offset: 7404},
 							name: "WS_MAND",
 						},
 						&litMatcher{
-							pos:        position{line: 159, col: 20, offset: 3403},
-							val:        "timeout",
+							pos:        position{line: 308, col: 17, offset: 7412},
+							val:        "when",
 							ignoreCase: false,
-							want:       "\"timeout\"",
+							want:       "\"when\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 159, col: 30, offset: 3413},
+							pos:  position{line: 308, col: 24, offset: 7419},
 							name: "WS_MAND",
 						},
 						&labeledExpr{
-							pos:   position{line: 159, col: 38, offset: 3421},
-							label: "t",
-							expr: &choiceExpr{
-								pos: position{line: 159, col: 41, offset: 3424},
-								alternatives: []interface{}{
-									&ruleRefExpr{
-										pos:  position{line: 159, col: 41, offset: 3424},
-										name: "VARIABLE",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 159, col: 52, offset: 3435},
-										name: "Integer",
+							pos:   position{line: 308, col: 32, offset: 7427},
+							label: "l",
+							expr: &ruleRefExpr{
+								pos:  position{line: 308, col: 35, offset: 7430},
+								name: "CONDITION_OPERAND",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 308, col: 54, offset: 7449},
+							label: "r",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 308, col: 56, offset: 7451},
+								expr: &seqExpr{
+									pos: position{line: 308, col: 57, offset: 7452},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 308, col: 57, offset: 7452},
+											name: "WS",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 308, col: 60, offset: 7455},
+											name: "CONDITION_OPERATOR",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 308, col: 79, offset: 7474},
+											name: "WS",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 308, col: 82, offset: 7477},
+											name: "CONDITION_OPERAND",
+										},
 									},
 								},
 							},
@@ -1871,43 +4069,49 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "MAX_AGE",
-			pos:  position{line: 163, col: 1, offset: 3471},
+			name: "CONDITION_OPERATOR",
+			pos:  position{line: 312, col: 1, offset: 7524},
 			expr: &actionExpr{
-				pos: position{line: 163, col: 12, offset: 3482},
-				run: (*parser).callonMAX_AGE1,
-				expr: &seqExpr{
-					pos: position{line: 163, col: 12, offset: 3482},
-					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 163, col: 12, offset: 3482},
-							name: "WS_MAND",
-						},
+				pos: position{line: 312, col: 23, offset: 7546},
+				run: (*parser).callonCONDITION_OPERATOR1,
+				expr: &choiceExpr{
+					pos: position{line: 312, col: 24, offset: 7547},
+					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 163, col: 20, offset: 3490},
-							val:        "max-age",
+							pos:        position{line: 312, col: 24, offset: 7547},
+							val:        "!=",
 							ignoreCase: false,
-							want:       "\"max-age\"",
+							want:       "\"!=\"",
 						},
-						&ruleRefExpr{
-							pos:  position{line: 163, col: 30, offset: 3500},
-							name: "WS_MAND",
+						&litMatcher{
+							pos:        position{line: 312, col: 31, offset: 7554},
+							val:        "=",
+							ignoreCase: false,
+							want:       "\"=\"",
 						},
-						&labeledExpr{
-							pos:   position{line: 163, col: 38, offset: 3508},
-							label: "t",
-							expr: &choiceExpr{
-								pos: position{line: 163, col: 41, offset: 3511},
-								alternatives: []interface{}{
-									&ruleRefExpr{
-										pos:  position{line: 163, col: 41, offset: 3511},
-										name: "VARIABLE",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 163, col: 52, offset: 3522},
-										name: "Integer",
-									},
-								},
+					},
+				},
+			},
+		},
+		{
+			name: "CONDITION_OPERAND",
+			pos:  position{line: 316, col: 1, offset: 7590},
+			expr: &actionExpr{
+				pos: position{line: 316, col: 22, offset: 7611},
+				run: (*parser).callonCONDITION_OPERAND1,
+				expr: &labeledExpr{
+					pos:   position{line: 316, col: 22, offset: 7611},
+					label: "v",
+					expr: &choiceExpr{
+						pos: position{line: 316, col: 25, offset: 7614},
+						alternatives: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 316, col: 25, offset: 7614},
+								name: "CONDITION_VARIABLE",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 316, col: 46, offset: 7635},
+								name: "PRIMITIVE",
 							},
 						},
 					},
@@ -1915,41 +4119,38 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "S_MAX_AGE",
-			pos:  position{line: 167, col: 1, offset: 3557},
+			name: "CONDITION_VARIABLE",
+			pos:  position{line: 320, col: 1, offset: 7671},
 			expr: &actionExpr{
-				pos: position{line: 167, col: 14, offset: 3570},
-				run: (*parser).callonS_MAX_AGE1,
+				pos: position{line: 320, col: 23, offset: 7693},
+				run: (*parser).callonCONDITION_VARIABLE1,
 				expr: &seqExpr{
-					pos: position{line: 167, col: 14, offset: 3570},
+					pos: position{line: 320, col: 23, offset: 7693},
 					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 167, col: 14, offset: 3570},
-							name: "WS_MAND",
-						},
-						&litMatcher{
-							pos:        position{line: 167, col: 22, offset: 3578},
-							val:        "s-max-age",
-							ignoreCase: false,
-							want:       "\"s-max-age\"",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 167, col: 34, offset: 3590},
-							name: "WS_MAND",
+						&labeledExpr{
+							pos:   position{line: 320, col: 23, offset: 7693},
+							label: "v",
+							expr: &ruleRefExpr{
+								pos:  position{line: 320, col: 26, offset: 7696},
+								name: "VARIABLE",
+							},
 						},
 						&labeledExpr{
-							pos:   position{line: 167, col: 42, offset: 3598},
-							label: "t",
-							expr: &choiceExpr{
-								pos: position{line: 167, col: 45, offset: 3601},
-								alternatives: []interface{}{
-									&ruleRefExpr{
-										pos:  position{line: 167, col: 45, offset: 3601},
-										name: "VARIABLE",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 167, col: 56, offset: 3612},
-										name: "Integer",
+							pos:   position{line: 320, col: 36, offset: 7706},
+							label: "m",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 320, col: 38, offset: 7708},
+								expr: &choiceExpr{
+									pos: position{line: 320, col: 39, offset: 7709},
+									alternatives: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 320, col: 39, offset: 7709},
+											name: "CONDITION_REQUIRED_MARKER",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 320, col: 67, offset: 7737},
+											name: "DEFAULT_VALUE",
+										},
 									},
 								},
 							},
@@ -1959,34 +4160,27 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "DEPENDS_ON",
-			pos:  position{line: 172, col: 1, offset: 3649},
+			name: "CONDITION_REQUIRED_MARKER",
+			pos:  position{line: 324, col: 1, offset: 7789},
 			expr: &actionExpr{
-				pos: position{line: 172, col: 15, offset: 3663},
-				run: (*parser).callonDEPENDS_ON1,
+				pos: position{line: 324, col: 30, offset: 7818},
+				run: (*parser).callonCONDITION_REQUIRED_MARKER1,
 				expr: &seqExpr{
-					pos: position{line: 172, col: 15, offset: 3663},
+					pos: position{line: 324, col: 30, offset: 7818},
 					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 172, col: 15, offset: 3663},
-							name: "WS_MAND",
-						},
 						&litMatcher{
-							pos:        position{line: 172, col: 23, offset: 3671},
-							val:        "depends-on",
+							pos:        position{line: 324, col: 30, offset: 7818},
+							val:        "!",
 							ignoreCase: false,
-							want:       "\"depends-on\"",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 172, col: 36, offset: 3684},
-							name: "WS_MAND",
+							want:       "\"!\"",
 						},
-						&labeledExpr{
-							pos:   position{line: 172, col: 44, offset: 3692},
-							label: "t",
-							expr: &ruleRefExpr{
-								pos:  position{line: 172, col: 47, offset: 3695},
-								name: "IDENT",
+						&notExpr{
+							pos: position{line: 324, col: 34, offset: 7822},
+							expr: &litMatcher{
+								pos:        position{line: 324, col: 35, offset: 7823},
+								val:        "=",
+								ignoreCase: false,
+								want:       "\"=\"",
 							},
 						},
 					},
@@ -1995,47 +4189,47 @@ var g = &grammar{
 		},
 		{
 			name: "FLAGS_RULE",
-			pos:  position{line: 176, col: 1, offset: 3731},
+			pos:  position{line: 328, col: 1, offset: 7860},
 			expr: &actionExpr{
-				pos: position{line: 176, col: 15, offset: 3745},
+				pos: position{line: 328, col: 15, offset: 7874},
 				run: (*parser).callonFLAGS_RULE1,
 				expr: &seqExpr{
-					pos: position{line: 176, col: 15, offset: 3745},
+					pos: position{line: 328, col: 15, offset: 7874},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 176, col: 15, offset: 3745},
+							pos:  position{line: 328, col: 15, offset: 7874},
 							name: "WS_MAND",
 						},
 						&labeledExpr{
-							pos:   position{line: 176, col: 23, offset: 3753},
+							pos:   position{line: 328, col: 23, offset: 7882},
 							label: "i",
 							expr: &ruleRefExpr{
-								pos:  position{line: 176, col: 25, offset: 3755},
+								pos:  position{line: 328, col: 25, offset: 7884},
 								name: "IGNORE_FLAG",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 176, col: 37, offset: 3767},
+							pos:   position{line: 328, col: 37, offset: 7896},
 							label: "is",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 176, col: 40, offset: 3770},
+								pos: position{line: 328, col: 40, offset: 7899},
 								expr: &seqExpr{
-									pos: position{line: 176, col: 41, offset: 3771},
+									pos: position{line: 328, col: 41, offset: 7900},
 									exprs: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 176, col: 41, offset: 3771},
+											pos:  position{line: 328, col: 41, offset: 7900},
 											name: "WS",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 176, col: 44, offset: 3774},
+											pos:  position{line: 328, col: 44, offset: 7903},
 											name: "LS",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 176, col: 47, offset: 3777},
+											pos:  position{line: 328, col: 47, offset: 7906},
 											name: "WS",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 176, col: 50, offset: 3780},
+											pos:  position{line: 328, col: 50, offset: 7909},
 											name: "IGNORE_FLAG",
 										},
 									},
@@ -2048,12 +4242,12 @@ var g = &grammar{
 		},
 		{
 			name: "IGNORE_FLAG",
-			pos:  position{line: 180, col: 1, offset: 3823},
+			pos:  position{line: 332, col: 1, offset: 7952},
 			expr: &actionExpr{
-				pos: position{line: 180, col: 16, offset: 3838},
+				pos: position{line: 332, col: 16, offset: 7967},
 				run: (*parser).callonIGNORE_FLAG1,
 				expr: &litMatcher{
-					pos:        position{line: 180, col: 16, offset: 3838},
+					pos:        position{line: 332, col: 16, offset: 7967},
 					val:        "ignore-errors",
 					ignoreCase: false,
 					want:       "\"ignore-errors\"",
@@ -2062,40 +4256,40 @@ var g = &grammar{
 		},
 		{
 			name: "CHAIN",
-			pos:  position{line: 184, col: 1, offset: 3885},
+			pos:  position{line: 336, col: 1, offset: 8014},
 			expr: &actionExpr{
-				pos: position{line: 184, col: 10, offset: 3894},
+				pos: position{line: 336, col: 10, offset: 8023},
 				run: (*parser).callonCHAIN1,
 				expr: &seqExpr{
-					pos: position{line: 184, col: 10, offset: 3894},
+					pos: position{line: 336, col: 10, offset: 8023},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 184, col: 10, offset: 3894},
+							pos:   position{line: 336, col: 10, offset: 8023},
 							label: "i",
 							expr: &ruleRefExpr{
-								pos:  position{line: 184, col: 13, offset: 3897},
+								pos:  position{line: 336, col: 13, offset: 8026},
 								name: "CHAINED_ITEM",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 184, col: 27, offset: 3911},
+							pos:   position{line: 336, col: 27, offset: 8040},
 							label: "ii",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 184, col: 30, offset: 3914},
+								pos: position{line: 336, col: 30, offset: 8043},
 								expr: &seqExpr{
-									pos: position{line: 184, col: 31, offset: 3915},
+									pos: position{line: 336, col: 31, offset: 8044},
 									exprs: []interface{}{
 										&zeroOrOneExpr{
-											pos: position{line: 184, col: 31, offset: 3915},
+											pos: position{line: 336, col: 31, offset: 8044},
 											expr: &litMatcher{
-												pos:        position{line: 184, col: 31, offset: 3915},
+												pos:        position{line: 336, col: 31, offset: 8044},
 												val:        ".",
 												ignoreCase: false,
 												want:       "\".\"",
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 184, col: 36, offset: 3920},
+											pos:  position{line: 336, col: 36, offset: 8049},
 											name: "CHAINED_ITEM",
 										},
 									},
@@ -2108,22 +4302,22 @@ var g = &grammar{
 		},
 		{
 			name: "CHAINED_ITEM",
-			pos:  position{line: 188, col: 1, offset: 3964},
+			pos:  position{line: 340, col: 1, offset: 8093},
 			expr: &actionExpr{
-				pos: position{line: 188, col: 17, offset: 3980},
+				pos: position{line: 340, col: 17, offset: 8109},
 				run: (*parser).callonCHAINED_ITEM1,
 				expr: &labeledExpr{
-					pos:   position{line: 188, col: 17, offset: 3980},
+					pos:   position{line: 340, col: 17, offset: 8109},
 					label: "ci",
 					expr: &choiceExpr{
-						pos: position{line: 188, col: 21, offset: 3984},
+						pos: position{line: 340, col: 21, offset: 8113},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 188, col: 21, offset: 3984},
+								pos:  position{line: 340, col: 21, offset: 8113},
 								name: "PATH_VARIABLE",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 188, col: 37, offset: 4000},
+								pos:  position{line: 340, col: 37, offset: 8129},
 								name: "IDENT",
 							},
 						},
@@ -2133,40 +4327,40 @@ var g = &grammar{
 		},
 		{
 			name: "PATH_VARIABLE",
-			pos:  position{line: 192, col: 1, offset: 4035},
+			pos:  position{line: 344, col: 1, offset: 8164},
 			expr: &actionExpr{
-				pos: position{line: 192, col: 18, offset: 4052},
+				pos: position{line: 344, col: 18, offset: 8181},
 				run: (*parser).callonPATH_VARIABLE1,
 				expr: &seqExpr{
-					pos: position{line: 192, col: 18, offset: 4052},
+					pos: position{line: 344, col: 18, offset: 8181},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 192, col: 18, offset: 4052},
+							pos: position{line: 344, col: 18, offset: 8181},
 							expr: &litMatcher{
-								pos:        position{line: 192, col: 18, offset: 4052},
+								pos:        position{line: 344, col: 18, offset: 8181},
 								val:        "[",
 								ignoreCase: false,
 								want:       "\"[\"",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 192, col: 23, offset: 4057},
+							pos:        position{line: 344, col: 23, offset: 8186},
 							val:        "$",
 							ignoreCase: false,
 							want:       "\"$\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 192, col: 27, offset: 4061},
+							pos:   position{line: 344, col: 27, offset: 8190},
 							label: "i",
 							expr: &ruleRefExpr{
-								pos:  position{line: 192, col: 30, offset: 4064},
+								pos:  position{line: 344, col: 30, offset: 8193},
 								name: "IDENT",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 192, col: 37, offset: 4071},
+							pos: position{line: 344, col: 37, offset: 8200},
 							expr: &litMatcher{
-								pos:        position{line: 192, col: 37, offset: 4071},
+								pos:        position{line: 344, col: 37, offset: 8200},
 								val:        "]",
 								ignoreCase: false,
 								want:       "\"]\"",
@@ -2178,24 +4372,24 @@ var g = &grammar{
 		},
 		{
 			name: "VARIABLE",
-			pos:  position{line: 196, col: 1, offset: 4113},
+			pos:  position{line: 348, col: 1, offset: 8242},
 			expr: &actionExpr{
-				pos: position{line: 196, col: 13, offset: 4125},
+				pos: position{line: 348, col: 13, offset: 8254},
 				run: (*parser).callonVARIABLE1,
 				expr: &seqExpr{
-					pos: position{line: 196, col: 13, offset: 4125},
+					pos: position{line: 348, col: 13, offset: 8254},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 196, col: 13, offset: 4125},
+							pos:        position{line: 348, col: 13, offset: 8254},
 							val:        "$",
 							ignoreCase: false,
 							want:       "\"$\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 196, col: 17, offset: 4129},
+							pos:   position{line: 348, col: 17, offset: 8258},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 196, col: 20, offset: 4132},
+								pos:  position{line: 348, col: 20, offset: 8261},
 								name: "IDENT_WITH_DOT",
 							},
 						},
@@ -2205,14 +4399,14 @@ var g = &grammar{
 		},
 		{
 			name: "IDENT",
-			pos:  position{line: 200, col: 1, offset: 4176},
+			pos:  position{line: 352, col: 1, offset: 8305},
 			expr: &actionExpr{
-				pos: position{line: 200, col: 10, offset: 4185},
+				pos: position{line: 352, col: 10, offset: 8314},
 				run: (*parser).callonIDENT1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 200, col: 10, offset: 4185},
+					pos: position{line: 352, col: 10, offset: 8314},
 					expr: &charClassMatcher{
-						pos:        position{line: 200, col: 10, offset: 4185},
+						pos:        position{line: 352, col: 10, offset: 8314},
 						val:        "[A-Za-z0-9:_-]",
 						chars:      []rune{':', '_', '-'},
 						ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -2224,14 +4418,14 @@ var g = &grammar{
 		},
 		{
 			name: "IDENT_WITHOUT_COLLON",
-			pos:  position{line: 204, col: 1, offset: 4232},
+			pos:  position{line: 356, col: 1, offset: 8361},
 			expr: &actionExpr{
-				pos: position{line: 204, col: 25, offset: 4256},
+				pos: position{line: 356, col: 25, offset: 8385},
 				run: (*parser).callonIDENT_WITHOUT_COLLON1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 204, col: 25, offset: 4256},
+					pos: position{line: 356, col: 25, offset: 8385},
 					expr: &charClassMatcher{
-						pos:        position{line: 204, col: 25, offset: 4256},
+						pos:        position{line: 356, col: 25, offset: 8385},
 						val:        "[A-Za-z0-9_-]",
 						chars:      []rune{'_', '-'},
 						ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
@@ -2243,14 +4437,14 @@ var g = &grammar{
 		},
 		{
 			name: "IDENT_WITH_DOT",
-			pos:  position{line: 208, col: 1, offset: 4302},
+			pos:  position{line: 360, col: 1, offset: 8431},
 			expr: &actionExpr{
-				pos: position{line: 208, col: 19, offset: 4320},
+				pos: position{line: 360, col: 19, offset: 8449},
 				run: (*parser).callonIDENT_WITH_DOT1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 208, col: 19, offset: 4320},
+					pos: position{line: 360, col: 19, offset: 8449},
 					expr: &charClassMatcher{
-						pos:        position{line: 208, col: 19, offset: 4320},
+						pos:        position{line: 360, col: 19, offset: 8449},
 						val:        "[a-zA-Z0-9-:_.]",
 						chars:      []rune{'-', ':', '_', '.'},
 						ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
@@ -2262,12 +4456,12 @@ var g = &grammar{
 		},
 		{
 			name: "Null",
-			pos:  position{line: 212, col: 1, offset: 4368},
+			pos:  position{line: 364, col: 1, offset: 8497},
 			expr: &actionExpr{
-				pos: position{line: 212, col: 9, offset: 4376},
+				pos: position{line: 364, col: 9, offset: 8505},
 				run: (*parser).callonNull1,
 				expr: &litMatcher{
-					pos:        position{line: 212, col: 9, offset: 4376},
+					pos:        position{line: 364, col: 9, offset: 8505},
 					val:        "null",
 					ignoreCase: false,
 					want:       "\"null\"",
@@ -2276,21 +4470,21 @@ var g = &grammar{
 		},
 		{
 			name: "Boolean",
-			pos:  position{line: 216, col: 1, offset: 4406},
+			pos:  position{line: 368, col: 1, offset: 8535},
 			expr: &actionExpr{
-				pos: position{line: 216, col: 12, offset: 4417},
+				pos: position{line: 368, col: 12, offset: 8546},
 				run: (*parser).callonBoolean1,
 				expr: &choiceExpr{
-					pos: position{line: 216, col: 13, offset: 4418},
+					pos: position{line: 368, col: 13, offset: 8547},
 					alternatives: []interface{}{
 						&litMatcher{
-							pos:        position{line: 216, col: 13, offset: 4418},
+							pos:        position{line: 368, col: 13, offset: 8547},
 							val:        "true",
 							ignoreCase: false,
 							want:       "\"true\"",
 						},
 						&litMatcher{
-							pos:        position{line: 216, col: 22, offset: 4427},
+							pos:        position{line: 368, col: 22, offset: 8556},
 							val:        "false",
 							ignoreCase: false,
 							want:       "\"false\"",
@@ -2299,43 +4493,191 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "TEMPLATE",
+			pos:  position{line: 372, col: 1, offset: 8597},
+			expr: &actionExpr{
+				pos: position{line: 372, col: 13, offset: 8609},
+				run: (*parser).callonTEMPLATE1,
+				expr: &seqExpr{
+					pos: position{line: 372, col: 13, offset: 8609},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 372, col: 13, offset: 8609},
+							val:        "\"",
+							ignoreCase: false,
+							want:       "\"\\\"\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 372, col: 17, offset: 8613},
+							label: "h",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 372, col: 19, offset: 8615},
+								expr: &ruleRefExpr{
+									pos:  position{line: 372, col: 20, offset: 8616},
+									name: "TEMPLATE_TEXT",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 372, col: 36, offset: 8632},
+							label: "p",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 372, col: 38, offset: 8634},
+								expr: &seqExpr{
+									pos: position{line: 372, col: 39, offset: 8635},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 372, col: 39, offset: 8635},
+											name: "TEMPLATE_EXPR",
+										},
+										&zeroOrOneExpr{
+											pos: position{line: 372, col: 53, offset: 8649},
+											expr: &ruleRefExpr{
+												pos:  position{line: 372, col: 53, offset: 8649},
+												name: "TEMPLATE_TEXT",
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 372, col: 70, offset: 8666},
+							val:        "\"",
+							ignoreCase: false,
+							want:       "\"\\\"\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TEMPLATE_TEXT",
+			pos:  position{line: 376, col: 1, offset: 8701},
+			expr: &actionExpr{
+				pos: position{line: 376, col: 18, offset: 8718},
+				run: (*parser).callonTEMPLATE_TEXT1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 376, col: 18, offset: 8718},
+					expr: &seqExpr{
+						pos: position{line: 376, col: 20, offset: 8720},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 376, col: 20, offset: 8720},
+								expr: &choiceExpr{
+									pos: position{line: 376, col: 22, offset: 8722},
+									alternatives: []interface{}{
+										&litMatcher{
+											pos:        position{line: 376, col: 22, offset: 8722},
+											val:        "\"",
+											ignoreCase: false,
+											want:       "\"\\\"\"",
+										},
+										&litMatcher{
+											pos:        position{line: 376, col: 28, offset: 8728},
+											val:        "${",
+											ignoreCase: false,
+											want:       "\"${\"",
+										},
+									},
+								},
+							},
+							&anyMatcher{
+								line: 376, col: 34, offset: 8734,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TEMPLATE_EXPR",
+			pos:  position{line: 380, col: 1, offset: 8776},
+			expr: &actionExpr{
+				pos: position{line: 380, col: 18, offset: 8793},
+				run: (*parser).callonTEMPLATE_EXPR1,
+				expr: &seqExpr{
+					pos: position{line: 380, col: 18, offset: 8793},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 380, col: 18, offset: 8793},
+							val:        "${",
+							ignoreCase: false,
+							want:       "\"${\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 380, col: 23, offset: 8798},
+							name: "WS",
+						},
+						&labeledExpr{
+							pos:   position{line: 380, col: 26, offset: 8801},
+							label: "e",
+							expr: &choiceExpr{
+								pos: position{line: 380, col: 29, offset: 8804},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 380, col: 29, offset: 8804},
+										name: "VARIABLE_VALUE",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 380, col: 46, offset: 8821},
+										name: "CHAIN",
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 380, col: 53, offset: 8828},
+							name: "WS",
+						},
+						&litMatcher{
+							pos:        position{line: 380, col: 56, offset: 8831},
+							val:        "}",
+							ignoreCase: false,
+							want:       "\"}\"",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "String",
-			pos:  position{line: 220, col: 1, offset: 4468},
+			pos:  position{line: 384, col: 1, offset: 8873},
 			expr: &actionExpr{
-				pos: position{line: 220, col: 11, offset: 4478},
+				pos: position{line: 384, col: 11, offset: 8883},
 				run: (*parser).callonString1,
 				expr: &seqExpr{
-					pos: position{line: 220, col: 11, offset: 4478},
+					pos: position{line: 384, col: 11, offset: 8883},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 220, col: 11, offset: 4478},
+							pos:        position{line: 384, col: 11, offset: 8883},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 220, col: 15, offset: 4482},
+							pos: position{line: 384, col: 15, offset: 8887},
 							expr: &seqExpr{
-								pos: position{line: 220, col: 17, offset: 4484},
+								pos: position{line: 384, col: 17, offset: 8889},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 220, col: 17, offset: 4484},
+										pos: position{line: 384, col: 17, offset: 8889},
 										expr: &litMatcher{
-											pos:        position{line: 220, col: 18, offset: 4485},
+											pos:        position{line: 384, col: 18, offset: 8890},
 											val:        "\"",
 											ignoreCase: false,
 											want:       "\"\\\"\"",
 										},
 									},
 									&anyMatcher{
-										line: 220, col: 22, offset: 4489,
+										line: 384, col: 22, offset: 8894,
 									},
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 220, col: 27, offset: 4494},
+							pos:        position{line: 384, col: 27, offset: 8899},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
@@ -2346,26 +4688,26 @@ var g = &grammar{
 		},
 		{
 			name: "Float",
-			pos:  position{line: 224, col: 1, offset: 4529},
+			pos:  position{line: 388, col: 1, offset: 8934},
 			expr: &actionExpr{
-				pos: position{line: 224, col: 10, offset: 4538},
+				pos: position{line: 388, col: 10, offset: 8943},
 				run: (*parser).callonFloat1,
 				expr: &seqExpr{
-					pos: position{line: 224, col: 10, offset: 4538},
+					pos: position{line: 388, col: 10, offset: 8943},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 224, col: 10, offset: 4538},
+							pos: position{line: 388, col: 10, offset: 8943},
 							expr: &choiceExpr{
-								pos: position{line: 224, col: 11, offset: 4539},
+								pos: position{line: 388, col: 11, offset: 8944},
 								alternatives: []interface{}{
 									&litMatcher{
-										pos:        position{line: 224, col: 11, offset: 4539},
+										pos:        position{line: 388, col: 11, offset: 8944},
 										val:        "+",
 										ignoreCase: false,
 										want:       "\"+\"",
 									},
 									&litMatcher{
-										pos:        position{line: 224, col: 17, offset: 4545},
+										pos:        position{line: 388, col: 17, offset: 8950},
 										val:        "-",
 										ignoreCase: false,
 										want:       "\"-\"",
@@ -2374,17 +4716,17 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 224, col: 23, offset: 4551},
+							pos:  position{line: 388, col: 23, offset: 8956},
 							name: "Natural",
 						},
 						&litMatcher{
-							pos:        position{line: 224, col: 31, offset: 4559},
+							pos:        position{line: 388, col: 31, offset: 8964},
 							val:        ".",
 							ignoreCase: false,
 							want:       "\".\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 224, col: 35, offset: 4563},
+							pos:  position{line: 388, col: 35, offset: 8968},
 							name: "Natural",
 						},
 					},
@@ -2393,26 +4735,26 @@ var g = &grammar{
 		},
 		{
 			name: "Integer",
-			pos:  position{line: 228, col: 1, offset: 4601},
+			pos:  position{line: 392, col: 1, offset: 9006},
 			expr: &actionExpr{
-				pos: position{line: 228, col: 12, offset: 4612},
+				pos: position{line: 392, col: 12, offset: 9017},
 				run: (*parser).callonInteger1,
 				expr: &seqExpr{
-					pos: position{line: 228, col: 12, offset: 4612},
+					pos: position{line: 392, col: 12, offset: 9017},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 228, col: 12, offset: 4612},
+							pos: position{line: 392, col: 12, offset: 9017},
 							expr: &choiceExpr{
-								pos: position{line: 228, col: 13, offset: 4613},
+								pos: position{line: 392, col: 13, offset: 9018},
 								alternatives: []interface{}{
 									&litMatcher{
-										pos:        position{line: 228, col: 13, offset: 4613},
+										pos:        position{line: 392, col: 13, offset: 9018},
 										val:        "+",
 										ignoreCase: false,
 										want:       "\"+\"",
 									},
 									&litMatcher{
-										pos:        position{line: 228, col: 19, offset: 4619},
+										pos:        position{line: 392, col: 19, offset: 9024},
 										val:        "-",
 										ignoreCase: false,
 										want:       "\"-\"",
@@ -2421,7 +4763,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 228, col: 25, offset: 4625},
+							pos:  position{line: 392, col: 25, offset: 9030},
 							name: "Natural",
 						},
 					},
@@ -2430,27 +4772,27 @@ var g = &grammar{
 		},
 		{
 			name: "Natural",
-			pos:  position{line: 232, col: 1, offset: 4665},
+			pos:  position{line: 396, col: 1, offset: 9070},
 			expr: &choiceExpr{
-				pos: position{line: 232, col: 11, offset: 4677},
+				pos: position{line: 396, col: 11, offset: 9082},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 232, col: 11, offset: 4677},
+						pos:        position{line: 396, col: 11, offset: 9082},
 						val:        "0",
 						ignoreCase: false,
 						want:       "\"0\"",
 					},
 					&seqExpr{
-						pos: position{line: 232, col: 17, offset: 4683},
+						pos: position{line: 396, col: 17, offset: 9088},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 232, col: 17, offset: 4683},
+								pos:  position{line: 396, col: 17, offset: 9088},
 								name: "NonZeroDecimalDigit",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 232, col: 37, offset: 4703},
+								pos: position{line: 396, col: 37, offset: 9108},
 								expr: &ruleRefExpr{
-									pos:  position{line: 232, col: 37, offset: 4703},
+									pos:  position{line: 396, col: 37, offset: 9108},
 									name: "DecimalDigit",
 								},
 							},
@@ -2461,9 +4803,9 @@ var g = &grammar{
 		},
 		{
 			name: "DecimalDigit",
-			pos:  position{line: 234, col: 1, offset: 4718},
+			pos:  position{line: 398, col: 1, offset: 9123},
 			expr: &charClassMatcher{
-				pos:        position{line: 234, col: 16, offset: 4735},
+				pos:        position{line: 398, col: 16, offset: 9140},
 				val:        "[0-9]",
 				ranges:     []rune{'0', '9'},
 				ignoreCase: false,
@@ -2472,9 +4814,9 @@ var g = &grammar{
 		},
 		{
 			name: "NonZeroDecimalDigit",
-			pos:  position{line: 235, col: 1, offset: 4741},
+			pos:  position{line: 399, col: 1, offset: 9146},
 			expr: &charClassMatcher{
-				pos:        position{line: 235, col: 23, offset: 4765},
+				pos:        position{line: 399, col: 23, offset: 9170},
 				val:        "[1-9]",
 				ranges:     []rune{'1', '9'},
 				ignoreCase: false,
@@ -2483,9 +4825,9 @@ var g = &grammar{
 		},
 		{
 			name: "SPACE",
-			pos:  position{line: 237, col: 1, offset: 4772},
+			pos:  position{line: 401, col: 1, offset: 9177},
 			expr: &charClassMatcher{
-				pos:        position{line: 237, col: 10, offset: 4781},
+				pos:        position{line: 401, col: 10, offset: 9186},
 				val:        "[ \\t]",
 				chars:      []rune{' ', '\t'},
 				ignoreCase: false,
@@ -2495,22 +4837,22 @@ var g = &grammar{
 		{
 			name:        "WS_MAND",
 			displayName: "\"mandatory-whitespace\"",
-			pos:         position{line: 238, col: 1, offset: 4787},
+			pos:         position{line: 402, col: 1, offset: 9192},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 238, col: 35, offset: 4821},
+				pos: position{line: 402, col: 35, offset: 9226},
 				expr: &choiceExpr{
-					pos: position{line: 238, col: 36, offset: 4822},
+					pos: position{line: 402, col: 36, offset: 9227},
 					alternatives: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 238, col: 36, offset: 4822},
+							pos:  position{line: 402, col: 36, offset: 9227},
 							name: "SPACE",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 238, col: 44, offset: 4830},
+							pos:  position{line: 402, col: 44, offset: 9235},
 							name: "COMMENT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 238, col: 54, offset: 4840},
+							pos:  position{line: 402, col: 54, offset: 9245},
 							name: "NL",
 						},
 					},
@@ -2520,18 +4862,18 @@ var g = &grammar{
 		{
 			name:        "WS",
 			displayName: "\"whitespace\"",
-			pos:         position{line: 239, col: 1, offset: 4845},
+			pos:         position{line: 403, col: 1, offset: 9250},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 239, col: 20, offset: 4864},
+				pos: position{line: 403, col: 20, offset: 9269},
 				expr: &choiceExpr{
-					pos: position{line: 239, col: 21, offset: 4865},
+					pos: position{line: 403, col: 21, offset: 9270},
 					alternatives: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 239, col: 21, offset: 4865},
+							pos:  position{line: 403, col: 21, offset: 9270},
 							name: "SPACE",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 239, col: 29, offset: 4873},
+							pos:  position{line: 403, col: 29, offset: 9278},
 							name: "COMMENT",
 						},
 					},
@@ -2541,22 +4883,22 @@ var g = &grammar{
 		{
 			name:        "LS",
 			displayName: "\"line-separator\"",
-			pos:         position{line: 240, col: 1, offset: 4883},
+			pos:         position{line: 404, col: 1, offset: 9288},
 			expr: &choiceExpr{
-				pos: position{line: 240, col: 25, offset: 4907},
+				pos: position{line: 404, col: 25, offset: 9312},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 240, col: 25, offset: 4907},
+						pos:  position{line: 404, col: 25, offset: 9312},
 						name: "NL",
 					},
 					&litMatcher{
-						pos:        position{line: 240, col: 30, offset: 4912},
+						pos:        position{line: 404, col: 30, offset: 9317},
 						val:        ",",
 						ignoreCase: false,
 						want:       "\",\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 240, col: 36, offset: 4918},
+						pos:  position{line: 404, col: 36, offset: 9323},
 						name: "COMMENT",
 					},
 				},
@@ -2565,31 +4907,31 @@ var g = &grammar{
 		{
 			name:        "BS",
 			displayName: "\"block-separator\"",
-			pos:         position{line: 241, col: 1, offset: 4927},
+			pos:         position{line: 405, col: 1, offset: 9332},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 241, col: 25, offset: 4951},
+				pos: position{line: 405, col: 25, offset: 9356},
 				expr: &seqExpr{
-					pos: position{line: 241, col: 26, offset: 4952},
+					pos: position{line: 405, col: 26, offset: 9357},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 241, col: 26, offset: 4952},
+							pos:  position{line: 405, col: 26, offset: 9357},
 							name: "WS",
 						},
 						&choiceExpr{
-							pos: position{line: 241, col: 30, offset: 4956},
+							pos: position{line: 405, col: 30, offset: 9361},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 241, col: 30, offset: 4956},
+									pos:  position{line: 405, col: 30, offset: 9361},
 									name: "NL",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 241, col: 35, offset: 4961},
+									pos:  position{line: 405, col: 35, offset: 9366},
 									name: "COMMENT",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 241, col: 44, offset: 4970},
+							pos:  position{line: 405, col: 44, offset: 9375},
 							name: "WS",
 						},
 					},
@@ -2599,9 +4941,9 @@ var g = &grammar{
 		{
 			name:        "NL",
 			displayName: "\"new-line\"",
-			pos:         position{line: 242, col: 1, offset: 4975},
+			pos:         position{line: 406, col: 1, offset: 9380},
 			expr: &litMatcher{
-				pos:        position{line: 242, col: 18, offset: 4992},
+				pos:        position{line: 406, col: 18, offset: 9397},
 				val:        "\n",
 				ignoreCase: false,
 				want:       "\"\\n\"",
@@ -2609,47 +4951,47 @@ var g = &grammar{
 		},
 		{
 			name: "COMMENT",
-			pos:  position{line: 244, col: 1, offset: 4998},
+			pos:  position{line: 408, col: 1, offset: 9403},
 			expr: &seqExpr{
-				pos: position{line: 244, col: 12, offset: 5009},
+				pos: position{line: 408, col: 12, offset: 9414},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 244, col: 12, offset: 5009},
+						pos:        position{line: 408, col: 12, offset: 9414},
 						val:        "//",
 						ignoreCase: false,
 						want:       "\"//\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 244, col: 17, offset: 5014},
+						pos: position{line: 408, col: 17, offset: 9419},
 						expr: &seqExpr{
-							pos: position{line: 244, col: 19, offset: 5016},
+							pos: position{line: 408, col: 19, offset: 9421},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 244, col: 19, offset: 5016},
+									pos: position{line: 408, col: 19, offset: 9421},
 									expr: &litMatcher{
-										pos:        position{line: 244, col: 20, offset: 5017},
+										pos:        position{line: 408, col: 20, offset: 9422},
 										val:        "\n",
 										ignoreCase: false,
 										want:       "\"\\n\"",
 									},
 								},
 								&anyMatcher{
-									line: 244, col: 25, offset: 5022,
+									line: 408, col: 25, offset: 9427,
 								},
 							},
 						},
 					},
 					&choiceExpr{
-						pos: position{line: 244, col: 31, offset: 5028},
+						pos: position{line: 408, col: 31, offset: 9433},
 						alternatives: []interface{}{
 							&litMatcher{
-								pos:        position{line: 244, col: 31, offset: 5028},
+								pos:        position{line: 408, col: 31, offset: 9433},
 								val:        "\n",
 								ignoreCase: false,
 								want:       "\"\\n\"",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 244, col: 38, offset: 5035},
+								pos:  position{line: 408, col: 38, offset: 9440},
 								name: "EOF",
 							},
 						},
@@ -2659,35 +5001,115 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 246, col: 1, offset: 5041},
+			pos:  position{line: 410, col: 1, offset: 9446},
 			expr: &notExpr{
-				pos: position{line: 246, col: 8, offset: 5048},
+				pos: position{line: 410, col: 8, offset: 9453},
 				expr: &anyMatcher{
-					line: 246, col: 9, offset: 5049,
+					line: 410, col: 9, offset: 9454,
 				},
 			},
 		},
 	},
 }
 
-func (c *current) onQUERY1(us, firstBlock, otherBlocks interface{}) (interface{}, error) {
-	return newQuery(us, firstBlock, otherBlocks)
+func (c *current) onQUERY1(us, is, firstBlock, otherBlocks interface{}) (interface{}, error) {
+	return newQuery(us, is, firstBlock, otherBlocks)
+}
+
+func (p *parser) callonQUERY1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQUERY1(stack["us"], stack["is"], stack["firstBlock"], stack["otherBlocks"])
+}
+
+func (c *current) onUSE1(u interface{}) (interface{}, error) {
+	return u, nil
 }
 
-func (p *parser) callonQUERY1() (interface{}, error) {
+func (p *parser) callonUSE1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUSE1(stack["u"])
+}
+
+func (c *current) onUSE_MULTIPLEX1(m interface{}) (interface{}, error) {
+	return newUseMultiplex(m)
+}
+
+func (p *parser) callonUSE_MULTIPLEX1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUSE_MULTIPLEX1(stack["m"])
+}
+
+func (c *current) onMULTIPLEX_MODE1() (interface{}, error) {
+	return stringify(c.text)
+}
+
+func (p *parser) callonMULTIPLEX_MODE1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onQUERY1(stack["us"], stack["firstBlock"], stack["otherBlocks"])
+	return p.cur.onMULTIPLEX_MODE1()
 }
 
-func (c *current) onUSE1(r, v interface{}) (interface{}, error) {
+func (c *current) onUSE_MODIFIER1(r, v interface{}) (interface{}, error) {
 	return newUse(r, v)
 }
 
-func (p *parser) callonUSE1() (interface{}, error) {
+func (p *parser) callonUSE_MODIFIER1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUSE_MODIFIER1(stack["r"], stack["v"])
+}
+
+func (c *current) onUSE_PARAMS1(p, ps interface{}) (interface{}, error) {
+	return newUseParams(p, ps)
+}
+
+func (p *parser) callonUSE_PARAMS1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUSE_PARAMS1(stack["p"], stack["ps"])
+}
+
+func (c *current) onPARAM_DECLARATION1(n, t, r, d interface{}) (interface{}, error) {
+	return newParamDeclaration(n, t, r, d)
+}
+
+func (p *parser) callonPARAM_DECLARATION1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onUSE1(stack["r"], stack["v"])
+	return p.cur.onPARAM_DECLARATION1(stack["n"], stack["t"], stack["r"], stack["d"])
+}
+
+func (c *current) onPARAM_TYPE1(t interface{}) (interface{}, error) {
+	return t, nil
+}
+
+func (p *parser) callonPARAM_TYPE1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPARAM_TYPE1(stack["t"])
+}
+
+func (c *current) onPARAM_SCALAR1() (interface{}, error) {
+	return newParamType(c.text)
+}
+
+func (p *parser) callonPARAM_SCALAR1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPARAM_SCALAR1()
+}
+
+func (c *current) onPARAM_ENUM1(v, vs interface{}) (interface{}, error) {
+	return newParamEnum(v, vs)
+}
+
+func (p *parser) callonPARAM_ENUM1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPARAM_ENUM1(stack["v"], stack["vs"])
 }
 
 func (c *current) onUSE_ACTION1() (interface{}, error) {
@@ -2710,6 +5132,16 @@ func (p *parser) callonUSE_VALUE1() (interface{}, error) {
 	return p.cur.onUSE_VALUE1(stack["v"])
 }
 
+func (c *current) onINCLUDE1(ns, q, r, a interface{}) (interface{}, error) {
+	return newInclude(ns, q, r, a)
+}
+
+func (p *parser) callonINCLUDE1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onINCLUDE1(stack["ns"], stack["q"], stack["r"], stack["a"])
+}
+
 func (c *current) onBLOCK1(action, m, w, f, fl interface{}) (interface{}, error) {
 	return newBlock(action, m, w, f, fl)
 }
@@ -2750,14 +5182,34 @@ func (p *parser) callonALIAS1() (interface{}, error) {
 	return p.cur.onALIAS1(stack["a"])
 }
 
-func (c *current) onIN1(t interface{}) (interface{}, error) {
-	return newIn(t)
+func (c *current) onIN1(t, b interface{}) (interface{}, error) {
+	return newIn(t, b)
 }
 
 func (p *parser) callonIN1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onIN1(stack["t"])
+	return p.cur.onIN1(stack["t"], stack["b"])
+}
+
+func (c *current) onIN_BY1(t, o, u interface{}) (interface{}, error) {
+	return newAggregationKey(t, o, u)
+}
+
+func (p *parser) callonIN_BY1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIN_BY1(stack["t"], stack["o"], stack["u"])
+}
+
+func (c *current) onUNMATCHED_MODE1() (interface{}, error) {
+	return stringify(c.text)
+}
+
+func (p *parser) callonUNMATCHED_MODE1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUNMATCHED_MODE1()
 }
 
 func (c *current) onMODIFIER_RULE1(m interface{}) (interface{}, error) {
@@ -2780,14 +5232,14 @@ func (p *parser) callonWITH_RULE1() (interface{}, error) {
 	return p.cur.onWITH_RULE1(stack["pb"], stack["kvs"])
 }
 
-func (c *current) onPARAMETER_BODY1(t, fn interface{}) (interface{}, error) {
-	return newParameterBody(t, fn)
+func (c *current) onPARAMETER_BODY1(t, m, fn interface{}) (interface{}, error) {
+	return newParameterBody(t, m, fn)
 }
 
 func (p *parser) callonPARAMETER_BODY1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPARAMETER_BODY1(stack["t"], stack["fn"])
+	return p.cur.onPARAMETER_BODY1(stack["t"], stack["m"], stack["fn"])
 }
 
 func (c *current) onKEY_VALUE_LIST1(first, others interface{}) (interface{}, error) {
@@ -2820,14 +5272,54 @@ func (p *parser) callonAPPLY_FN1() (interface{}, error) {
 	return p.cur.onAPPLY_FN1(stack["fn"])
 }
 
-func (c *current) onFUNCTION1() (interface{}, error) {
-	return stringify(c.text)
+func (c *current) onFUNCTION1(n, a interface{}) (interface{}, error) {
+	return newFunction(n, a)
 }
 
 func (p *parser) callonFUNCTION1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFUNCTION1()
+	return p.cur.onFUNCTION1(stack["n"], stack["a"])
+}
+
+func (c *current) onFUNCTION_NAME1() (interface{}, error) {
+	return stringify(c.text)
+}
+
+func (p *parser) callonFUNCTION_NAME1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFUNCTION_NAME1()
+}
+
+func (c *current) onFUNCTION_ARGS1(a interface{}) (interface{}, error) {
+	return a, nil
+}
+
+func (p *parser) callonFUNCTION_ARGS1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFUNCTION_ARGS1(stack["a"])
+}
+
+func (c *current) onEMPTY_FUNCTION_ARGS1() (interface{}, error) {
+	return []Value{}, nil
+}
+
+func (p *parser) callonEMPTY_FUNCTION_ARGS1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEMPTY_FUNCTION_ARGS1()
+}
+
+func (c *current) onPOPULATED_FUNCTION_ARGS1(a, as interface{}) (interface{}, error) {
+	return newFunctionArgs(a, as)
+}
+
+func (p *parser) callonPOPULATED_FUNCTION_ARGS1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPOPULATED_FUNCTION_ARGS1(stack["a"], stack["as"])
 }
 
 func (c *current) onVALUE1(v interface{}) (interface{}, error) {
@@ -2840,6 +5332,36 @@ func (p *parser) callonVALUE1() (interface{}, error) {
 	return p.cur.onVALUE1(stack["v"])
 }
 
+func (c *current) onVARIABLE_VALUE1(v, m interface{}) (interface{}, error) {
+	return newVariableValue(v, m)
+}
+
+func (p *parser) callonVARIABLE_VALUE1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVARIABLE_VALUE1(stack["v"], stack["m"])
+}
+
+func (c *current) onREQUIRED_MARKER1() (interface{}, error) {
+	return newRequiredMarker()
+}
+
+func (p *parser) callonREQUIRED_MARKER1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onREQUIRED_MARKER1()
+}
+
+func (c *current) onDEFAULT_VALUE1(d interface{}) (interface{}, error) {
+	return newDefaultValue(d)
+}
+
+func (p *parser) callonDEFAULT_VALUE1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDEFAULT_VALUE1(stack["d"])
+}
+
 func (c *current) onLIST1(l interface{}) (interface{}, error) {
 	return l, nil
 }
@@ -2930,14 +5452,44 @@ func (p *parser) callonONLY_RULE1() (interface{}, error) {
 	return p.cur.onONLY_RULE1(stack["f"], stack["fs"])
 }
 
-func (c *current) onFILTER1(f, fns interface{}) (interface{}, error) {
-	return newFilter(f, fns)
+func (c *current) onEXCLUDE_RULE1(p, ps interface{}) (interface{}, error) {
+	return newExclude(p, ps)
+}
+
+func (p *parser) callonEXCLUDE_RULE1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEXCLUDE_RULE1(stack["p"], stack["ps"])
+}
+
+func (c *current) onEXCLUDE_PATH1(p interface{}) (interface{}, error) {
+	return newExcludePath(p)
+}
+
+func (p *parser) callonEXCLUDE_PATH1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEXCLUDE_PATH1(stack["p"])
+}
+
+func (c *current) onFILTER1(f, fns, a interface{}) (interface{}, error) {
+	return newFilter(f, fns, a)
 }
 
 func (p *parser) callonFILTER1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFILTER1(stack["f"], stack["fns"])
+	return p.cur.onFILTER1(stack["f"], stack["fns"], stack["a"])
+}
+
+func (c *current) onFILTER_ALIAS1(a interface{}) (interface{}, error) {
+	return a, nil
+}
+
+func (p *parser) callonFILTER_ALIAS1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFILTER_ALIAS1(stack["a"])
 }
 
 func (c *current) onFILTER_VALUE1(fv interface{}) (interface{}, error) {
@@ -2990,6 +5542,66 @@ func (p *parser) callonFILTER_BY_REGEX1() (interface{}, error) {
 	return p.cur.onFILTER_BY_REGEX1(stack["path"], stack["regex"])
 }
 
+func (c *current) onSORT_BY1(path, order interface{}) (interface{}, error) {
+	return newSortBy(path, order)
+}
+
+func (p *parser) callonSORT_BY1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSORT_BY1(stack["path"], stack["order"])
+}
+
+func (c *current) onTAKE1(n interface{}) (interface{}, error) {
+	return newTake(n)
+}
+
+func (p *parser) callonTAKE1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTAKE1(stack["n"])
+}
+
+func (c *current) onSKIP1(n interface{}) (interface{}, error) {
+	return newSkip(n)
+}
+
+func (p *parser) callonSKIP1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSKIP1(stack["n"])
+}
+
+func (c *current) onDISTINCT1(path interface{}) (interface{}, error) {
+	return newDistinct(path)
+}
+
+func (p *parser) callonDISTINCT1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDISTINCT1(stack["path"])
+}
+
+func (c *current) onINDEX_BY1(path interface{}) (interface{}, error) {
+	return newIndexBy(path)
+}
+
+func (p *parser) callonINDEX_BY1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onINDEX_BY1(stack["path"])
+}
+
+func (c *current) onGROUP_BY1(path interface{}) (interface{}, error) {
+	return newGroupBy(path)
+}
+
+func (p *parser) callonGROUP_BY1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGROUP_BY1(stack["path"])
+}
+
 func (c *current) onHEADERS1(h, hs interface{}) (interface{}, error) {
 	return newHeaders(h, hs)
 }
@@ -3020,6 +5632,36 @@ func (p *parser) callonHIDDEN_RULE1() (interface{}, error) {
 	return p.cur.onHIDDEN_RULE1()
 }
 
+func (c *current) onPAGINATE1(p, path, m interface{}) (interface{}, error) {
+	return newPaginate(p, path, m)
+}
+
+func (p *parser) callonPAGINATE1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPAGINATE1(stack["p"], stack["path"], stack["m"])
+}
+
+func (c *current) onRETRY1(a, b, u interface{}) (interface{}, error) {
+	return newRetry(a, b, u)
+}
+
+func (p *parser) callonRETRY1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRETRY1(stack["a"], stack["b"], stack["u"])
+}
+
+func (c *current) onON_ERROR1(v interface{}) (interface{}, error) {
+	return newOnError(v)
+}
+
+func (p *parser) callonON_ERROR1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onON_ERROR1(stack["v"])
+}
+
 func (c *current) onTIMEOUT1(t interface{}) (interface{}, error) {
 	return newTimeout(t)
 }
@@ -3050,14 +5692,94 @@ func (p *parser) callonS_MAX_AGE1() (interface{}, error) {
 	return p.cur.onS_MAX_AGE1(stack["t"])
 }
 
-func (c *current) onDEPENDS_ON1(t interface{}) (interface{}, error) {
-	return newDependsOn(t)
+func (c *current) onDEPENDS_ON1(t, cond interface{}) (interface{}, error) {
+	return newDependsOn(t, cond)
 }
 
 func (p *parser) callonDEPENDS_ON1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onDEPENDS_ON1(stack["t"])
+	return p.cur.onDEPENDS_ON1(stack["t"], stack["cond"])
+}
+
+func (c *current) onDEPENDS_ON_TARGETS1(t, ts interface{}) (interface{}, error) {
+	return newDependsOnTargets(t, ts)
+}
+
+func (p *parser) callonDEPENDS_ON_TARGETS1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDEPENDS_ON_TARGETS1(stack["t"], stack["ts"])
+}
+
+func (c *current) onDEPENDS_ON_CONDITION1(v interface{}) (interface{}, error) {
+	return v, nil
+}
+
+func (p *parser) callonDEPENDS_ON_CONDITION1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDEPENDS_ON_CONDITION1(stack["v"])
+}
+
+func (c *current) onDEPENDS_ON_CONDITION_VALUE1() (interface{}, error) {
+	return stringify(c.text)
+}
+
+func (p *parser) callonDEPENDS_ON_CONDITION_VALUE1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDEPENDS_ON_CONDITION_VALUE1()
+}
+
+func (c *current) onWHEN1(l, r interface{}) (interface{}, error) {
+	return newWhen(l, r)
+}
+
+func (p *parser) callonWHEN1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWHEN1(stack["l"], stack["r"])
+}
+
+func (c *current) onCONDITION_OPERATOR1() (interface{}, error) {
+	return stringify(c.text)
+}
+
+func (p *parser) callonCONDITION_OPERATOR1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCONDITION_OPERATOR1()
+}
+
+func (c *current) onCONDITION_OPERAND1(v interface{}) (interface{}, error) {
+	return newValue(v)
+}
+
+func (p *parser) callonCONDITION_OPERAND1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCONDITION_OPERAND1(stack["v"])
+}
+
+func (c *current) onCONDITION_VARIABLE1(v, m interface{}) (interface{}, error) {
+	return newVariableValue(v, m)
+}
+
+func (p *parser) callonCONDITION_VARIABLE1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCONDITION_VARIABLE1(stack["v"], stack["m"])
+}
+
+func (c *current) onCONDITION_REQUIRED_MARKER1() (interface{}, error) {
+	return newRequiredMarker()
+}
+
+func (p *parser) callonCONDITION_REQUIRED_MARKER1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCONDITION_REQUIRED_MARKER1()
 }
 
 func (c *current) onFLAGS_RULE1(i, is interface{}) (interface{}, error) {
@@ -3170,6 +5892,36 @@ func (p *parser) callonBoolean1() (interface{}, error) {
 	return p.cur.onBoolean1()
 }
 
+func (c *current) onTEMPLATE1(h, p interface{}) (interface{}, error) {
+	return newTemplate(h, p)
+}
+
+func (p *parser) callonTEMPLATE1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTEMPLATE1(stack["h"], stack["p"])
+}
+
+func (c *current) onTEMPLATE_TEXT1() (interface{}, error) {
+	return newTemplateText(c.text)
+}
+
+func (p *parser) callonTEMPLATE_TEXT1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTEMPLATE_TEXT1()
+}
+
+func (c *current) onTEMPLATE_EXPR1(e interface{}) (interface{}, error) {
+	return newTemplateExpression(e)
+}
+
+func (p *parser) callonTEMPLATE_EXPR1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTEMPLATE_EXPR1(stack["e"])
+}
+
 func (c *current) onString1() (interface{}, error) {
 	return newString(c.text)
 }
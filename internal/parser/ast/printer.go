@@ -0,0 +1,475 @@
+package ast
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const indentation = "    "
+
+// Print transforms an AST back into restQL text using the canonical
+// layout: one clause per line, in the order they must be declared,
+// with its items indented on the following lines.
+// Comments are not part of the AST, hence are not preserved.
+func Print(query *Query) string {
+	var sb strings.Builder
+
+	for _, u := range query.Use {
+		if u.Key == MultiplexKeyword {
+			sb.WriteString(fmt.Sprintf("use %s %s\n", u.Key, *u.Value.String))
+			continue
+		}
+
+		sb.WriteString(fmt.Sprintf("use %s %s\n", u.Key, printUseValue(u.Value)))
+	}
+
+	for _, inc := range query.Include {
+		sb.WriteString(fmt.Sprintf("include %s/%s/%d", inc.Namespace, inc.Query, inc.Revision))
+		if inc.Alias != "" {
+			sb.WriteString(" as " + inc.Alias)
+		}
+		sb.WriteString("\n")
+	}
+
+	if sb.Len() > 0 && len(query.Blocks) > 0 {
+		sb.WriteString("\n")
+	}
+
+	blocks := make([]string, len(query.Blocks))
+	for i, b := range query.Blocks {
+		blocks[i] = printBlock(b)
+	}
+	sb.WriteString(strings.Join(blocks, "\n"))
+
+	return sb.String()
+}
+
+func printUseValue(v UseValue) string {
+	if v.Params != nil {
+		declarations := make([]string, len(v.Params))
+		for i, p := range v.Params {
+			declarations[i] = printParamDeclaration(p)
+		}
+		return "{" + strings.Join(declarations, ", ") + "}"
+	}
+
+	if v.Int != nil {
+		return strconv.Itoa(*v.Int)
+	}
+
+	return quote(*v.String)
+}
+
+func printParamDeclaration(p ParamDeclaration) string {
+	declaration := p.Name + ": " + p.Type
+	if p.Type == EnumParamType {
+		values := make([]string, len(p.Enum))
+		for i, e := range p.Enum {
+			values[i] = quote(e)
+		}
+		declaration += "(" + strings.Join(values, ", ") + ")"
+	}
+
+	if p.Required {
+		declaration += " " + RequiredKeyword
+	}
+
+	if p.Default != nil {
+		declaration += " = " + printValue(*p.Default)
+	}
+
+	return declaration
+}
+
+func printBlock(block Block) string {
+	var sb strings.Builder
+
+	sb.WriteString(block.Method + " " + block.Resource)
+	if block.Alias != "" {
+		sb.WriteString(" as " + block.Alias)
+	}
+	if len(block.In) > 0 {
+		sb.WriteString(" in " + strings.Join(block.In, "."))
+	}
+	if block.InBy != nil {
+		sb.WriteString(" by " + strings.Join(block.InBy.Target, ".") + " = " + strings.Join(block.InBy.Origin, "."))
+		if block.InBy.Unmatched != "" {
+			sb.WriteString(" " + UnmatchedKeyword + " " + block.InBy.Unmatched)
+		}
+	}
+	sb.WriteString("\n")
+
+	qualifiers := make([]Qualifier, len(block.Qualifiers))
+	copy(qualifiers, block.Qualifiers)
+	sort.SliceStable(qualifiers, func(i, j int) bool {
+		return qualifierOrder(qualifiers[i]) < qualifierOrder(qualifiers[j])
+	})
+
+	ignoreErrors := false
+	for _, q := range qualifiers {
+		if q.IgnoreErrors {
+			ignoreErrors = true
+			continue
+		}
+
+		sb.WriteString(printQualifier(q))
+	}
+
+	if ignoreErrors {
+		sb.WriteString(indentation + IgnoreErrorsKeyword + "\n")
+	}
+
+	return sb.String()
+}
+
+func qualifierOrder(q Qualifier) int {
+	switch {
+	case q.Headers != nil:
+		return 0
+	case q.Timeout != nil:
+		return 1
+	case q.MaxAge != nil:
+		return 2
+	case q.SMaxAge != nil:
+		return 3
+	case q.DependsOn != nil:
+		return 4
+	case q.When != nil:
+		return 5
+	case q.Paginate != nil:
+		return 6
+	case q.Retry != nil:
+		return 7
+	case q.OnError != nil:
+		return 8
+	case q.With != nil:
+		return 9
+	case q.Only != nil, q.Exclude != nil, q.Hidden:
+		return 10
+	default:
+		return 11
+	}
+}
+
+func printQualifier(q Qualifier) string {
+	switch {
+	case q.Headers != nil:
+		items := make([]string, len(q.Headers))
+		for i, h := range q.Headers {
+			items[i] = h.Key + " = " + printHeaderValue(h.Value)
+		}
+		return printClause(HeadersKeyword, items)
+	case q.Timeout != nil:
+		return printModifier(TimeoutKeyword, printVariableOrInt(variableOrInt(*q.Timeout)))
+	case q.MaxAge != nil:
+		return printModifier(MaxAgeKeyword, printVariableOrInt(variableOrInt(*q.MaxAge)))
+	case q.SMaxAge != nil:
+		return printModifier(SmaxAgeKeyword, printVariableOrInt(variableOrInt(*q.SMaxAge)))
+	case q.DependsOn != nil:
+		dependsOn := q.DependsOn.Targets[0]
+		if len(q.DependsOn.Targets) > 1 {
+			dependsOn = "[" + strings.Join(q.DependsOn.Targets, ", ") + "]"
+		}
+		if q.DependsOn.Condition != "" {
+			dependsOn += " on " + q.DependsOn.Condition
+		}
+		return printModifier(DependsOnKeyword, dependsOn)
+	case q.When != nil:
+		condition := printValue(q.When.Left)
+		if q.When.Right != nil {
+			condition += " " + q.When.Operator + " " + printValue(*q.When.Right)
+		}
+		return printModifier(WhenKeyword, condition)
+	case q.Paginate != nil:
+		pagination := q.Paginate.Param + " = " + strings.Join(q.Paginate.Path, ".")
+		if q.Paginate.MaxPages != nil {
+			pagination += " max " + printVariableOrInt(variableOrInt(*q.Paginate.MaxPages))
+		}
+		return printModifier(PaginateKeyword, pagination)
+	case q.Retry != nil:
+		retry := printVariableOrInt(variableOrInt(q.Retry.Attempts))
+		if q.Retry.Backoff != nil {
+			retry += " backoff " + printVariableOrInt(variableOrInt(*q.Retry.Backoff))
+		}
+		if q.Retry.Unsafe {
+			retry += " unsafe"
+		}
+		return printModifier(RetryKeyword, retry)
+	case q.OnError != nil:
+		return printModifier(OnErrorKeyword, "default "+printValue(q.OnError.Default))
+	case q.With != nil:
+		return printClause(WithKeyword, printParameters(*q.With))
+	case q.Only != nil:
+		items := make([]string, len(q.Only))
+		for i, f := range q.Only {
+			items[i] = printFilter(f)
+		}
+		return printClause(OnlyKeyword, items)
+	case q.Exclude != nil:
+		items := make([]string, len(q.Exclude))
+		for i, path := range q.Exclude {
+			items[i] = strings.Join(path, ".")
+		}
+		return printClause(ExcludeKeyword, items)
+	case q.Hidden:
+		return indentation + HiddenKeyword + "\n"
+	default:
+		return ""
+	}
+}
+
+func printModifier(keyword, value string) string {
+	return indentation + keyword + " " + value + "\n"
+}
+
+func printClause(keyword string, items []string) string {
+	var sb strings.Builder
+
+	sb.WriteString(indentation + keyword + "\n")
+	for _, item := range items {
+		sb.WriteString(indentation + indentation + item + "\n")
+	}
+
+	return sb.String()
+}
+
+func printParameters(params Parameters) []string {
+	var items []string
+
+	if params.Body != nil {
+		body := "$" + params.Body.Target
+		if params.Body.Required {
+			body += "!"
+		}
+		items = append(items, body+printFunctions(params.Body.Functions))
+	}
+
+	for _, kv := range params.KeyValues {
+		items = append(items, kv.Key+" = "+printValue(kv.Value)+printFunctions(kv.Functions))
+	}
+
+	return items
+}
+
+func printFunctions(functions []Function) string {
+	var sb strings.Builder
+	for _, fn := range functions {
+		sb.WriteString(" -> " + printFunction(fn))
+	}
+
+	return sb.String()
+}
+
+func printFunction(fn Function) string {
+	if len(fn.Arguments) == 0 {
+		return fn.Name
+	}
+
+	args := make([]string, len(fn.Arguments))
+	for i, a := range fn.Arguments {
+		args[i] = printValue(a)
+	}
+
+	return fn.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+func printFilter(filter Filter) string {
+	var sb strings.Builder
+
+	sb.WriteString(strings.Join(filter.Field, "."))
+	for _, fn := range filter.Functions {
+		sb.WriteString(" -> " + printFilterFunction(fn))
+	}
+
+	if filter.Alias != "" {
+		sb.WriteString(" as " + filter.Alias)
+	}
+
+	return sb.String()
+}
+
+func printFilterFunction(fn interface{}) string {
+	switch fn := fn.(type) {
+	case Match:
+		return Matches + "(" + printVariableOrString(fn.Variable, fn.String) + ")"
+	case FilterByRegex:
+		return "filterByRegex(" + printVariableOrString(fn.PathVariable, fn.PathString) + ", " + printVariableOrString(fn.RegexVariable, fn.RegexString) + ")"
+	case SortBy:
+		args := printVariableOrString(fn.PathVariable, fn.PathString)
+		if fn.OrderVariable != nil || fn.OrderString != nil {
+			args += ", " + printVariableOrString(fn.OrderVariable, fn.OrderString)
+		}
+		return SortByFunction + "(" + args + ")"
+	case Take:
+		return TakeFunction + "(" + printVariableValueOrInt(variableValueOrInt(fn)) + ")"
+	case Skip:
+		return SkipFunction + "(" + printVariableValueOrInt(variableValueOrInt(fn)) + ")"
+	case Distinct:
+		return DistinctFunction + "(" + printVariableOrString(fn.PathVariable, fn.PathString) + ")"
+	case IndexBy:
+		return IndexByFunction + "(" + printVariableOrString(fn.PathVariable, fn.PathString) + ")"
+	case GroupBy:
+		return GroupByFunction + "(" + printVariableOrString(fn.PathVariable, fn.PathString) + ")"
+	case Function:
+		return printFunction(fn)
+	default:
+		return ""
+	}
+}
+
+func printVariableOrString(variable *VariableValue, str *string) string {
+	switch {
+	case variable != nil:
+		return printVariableValue(*variable)
+	case str != nil:
+		return quote(*str)
+	default:
+		return ""
+	}
+}
+
+func printVariableOrInt(v variableOrInt) string {
+	if v.Variable != nil {
+		return "$" + *v.Variable
+	}
+
+	return strconv.Itoa(*v.Int)
+}
+
+func printVariableValueOrInt(v variableValueOrInt) string {
+	if v.Variable != nil {
+		return printVariableValue(*v.Variable)
+	}
+
+	return strconv.Itoa(*v.Int)
+}
+
+func printHeaderValue(hv HeaderValue) string {
+	switch {
+	case hv.Variable != nil:
+		return printVariable(*hv.Variable, hv.Required, hv.Default)
+	case hv.String != nil:
+		return quote(*hv.String)
+	case hv.Chain != nil:
+		return printChain(hv.Chain)
+	case hv.Template != nil:
+		return printTemplate(hv.Template)
+	default:
+		return ""
+	}
+}
+
+func printValue(v Value) string {
+	switch {
+	case v.Variable != nil:
+		return printVariable(*v.Variable, v.Required, v.Default)
+	case v.List != nil:
+		items := make([]string, len(v.List))
+		for i, item := range v.List {
+			items[i] = printValue(item)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case v.Object != nil:
+		entries := make([]string, len(v.Object))
+		for i, e := range v.Object {
+			entries[i] = printObjectKey(e.Key) + ": " + printValue(e.Value)
+		}
+		return "{" + strings.Join(entries, ", ") + "}"
+	case v.Primitive != nil:
+		return printPrimitive(*v.Primitive)
+	default:
+		return ""
+	}
+}
+
+func printVariableValue(v VariableValue) string {
+	return printVariable(v.Name, v.Required, v.Default)
+}
+
+func printVariable(name string, required bool, defaultValue *Value) string {
+	v := "$" + name
+	if required {
+		v += "!"
+	}
+	if defaultValue != nil {
+		v += " ?: " + printValue(*defaultValue)
+	}
+
+	return v
+}
+
+var objectKeyRegex = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+func printObjectKey(key string) string {
+	if objectKeyRegex.MatchString(key) {
+		return key
+	}
+
+	return quote(key)
+}
+
+func printPrimitive(p Primitive) string {
+	switch {
+	case p.String != nil:
+		return quote(*p.String)
+	case p.Int != nil:
+		return strconv.Itoa(*p.Int)
+	case p.Float != nil:
+		f := strconv.FormatFloat(*p.Float, 'f', -1, 64)
+		if !strings.Contains(f, ".") {
+			f += ".0"
+		}
+		return f
+	case p.Boolean != nil:
+		return strconv.FormatBool(*p.Boolean)
+	case p.Chain != nil:
+		return printChain(p.Chain)
+	case p.Template != nil:
+		return printTemplate(p.Template)
+	default:
+		return "null"
+	}
+}
+
+func printChain(chain []Chained) string {
+	items := make([]string, len(chain))
+	for i, c := range chain {
+		if c.PathVariable != "" {
+			items[i] = "$" + c.PathVariable
+		} else {
+			items[i] = c.PathItem
+		}
+	}
+
+	return strings.Join(items, ".")
+}
+
+func printTemplate(parts []TemplatePart) string {
+	var sb strings.Builder
+
+	sb.WriteString(`"`)
+	for _, p := range parts {
+		switch {
+		case p.Text != nil:
+			text := quote(*p.Text)
+			text = strings.ReplaceAll(text[1:len(text)-1], "${", `$\u007b`)
+			sb.WriteString(text)
+		case p.Variable != nil:
+			sb.WriteString("${" + printVariableValue(*p.Variable) + "}")
+		case p.Chain != nil:
+			sb.WriteString("${" + printChain(p.Chain) + "}")
+		}
+	}
+	sb.WriteString(`"`)
+
+	return sb.String()
+}
+
+// quote returns a restQL string literal, which can not contain
+// the double quote character even if escaped.
+func quote(s string) string {
+	return strings.ReplaceAll(strconv.Quote(s), `\"`, `\u0022`)
+}
@@ -6,12 +6,14 @@ import (
 
 	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
 	"github.com/b2wdigital/restQL-golang/v6/internal/parser/ast"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
 	"github.com/pkg/errors"
 )
 
-// Optimize transforms a restQL AST into the internal representation.
-func Optimize(queryAst *ast.Query) (domain.Query, error) {
-	statements, err := mapToStatements(queryAst.Blocks)
+// Optimize transforms a restQL AST into the internal representation,
+// binding the functions not built into restQL to the given plugins.
+func Optimize(queryAst *ast.Query, functions map[string]restql.FunctionPlugin) (domain.Query, error) {
+	statements, err := mapToStatements(queryAst.Blocks, functions)
 	if err != nil {
 		return domain.Query{}, err
 	}
@@ -20,6 +22,16 @@ func Optimize(queryAst *ast.Query) (domain.Query, error) {
 
 	if queryAst.Use != nil {
 		query.Use = makeUse(queryAst)
+
+		params, err := makeParamDeclarations(queryAst)
+		if err != nil {
+			return domain.Query{}, err
+		}
+		query.Params = params
+	}
+
+	if queryAst.Include != nil {
+		query.Include = makeInclude(queryAst)
 	}
 
 	return query, nil
@@ -29,6 +41,10 @@ func makeUse(queryAst *ast.Query) map[string]interface{} {
 	result := map[string]interface{}{}
 	for _, use := range queryAst.Use {
 		key := strings.Trim(use.Key, " ")
+		if use.Value.Params != nil {
+			continue
+		}
+
 		if use.Value.String != nil {
 			result[key] = *use.Value.String
 		} else {
@@ -38,13 +54,85 @@ func makeUse(queryAst *ast.Query) map[string]interface{} {
 	return result
 }
 
-func mapToStatements(fromBlocks []ast.Block) ([]domain.Statement, error) {
+func makeParamDeclarations(queryAst *ast.Query) ([]domain.Param, error) {
+	var result []domain.Param
+	for _, use := range queryAst.Use {
+		for _, declaration := range use.Value.Params {
+			param := domain.Param{
+				Name:     declaration.Name,
+				Type:     declaration.Type,
+				Enum:     declaration.Enum,
+				Required: declaration.Required,
+			}
+
+			if declaration.Default != nil {
+				value := getValue(*declaration.Default)
+				if !isLiteral(value) {
+					return nil, errors.Errorf("default value of param %s must be a literal", param.Name)
+				}
+
+				defaultValue, err := param.Coerce(value)
+				if err != nil {
+					return nil, errors.Errorf("default value of param %s %s", param.Name, err)
+				}
+				param.Default = defaultValue
+			}
+
+			result = append(result, param)
+		}
+	}
+
+	return result, nil
+}
+
+func isLiteral(value interface{}) bool {
+	switch value := value.(type) {
+	case nil, string, int, float64, bool:
+		return true
+	case []interface{}:
+		for _, v := range value {
+			if !isLiteral(v) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		for _, v := range value {
+			if !isLiteral(v) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+func makeInclude(queryAst *ast.Query) []domain.Include {
+	result := make([]domain.Include, len(queryAst.Include))
+	for i, inc := range queryAst.Include {
+		alias := inc.Alias
+		if alias == "" {
+			alias = inc.Query
+		}
+
+		result[i] = domain.Include{
+			Namespace: inc.Namespace,
+			Query:     inc.Query,
+			Revision:  inc.Revision,
+			Alias:     alias,
+		}
+	}
+	return result
+}
+
+func mapToStatements(fromBlocks []ast.Block, functions map[string]restql.FunctionPlugin) ([]domain.Statement, error) {
 	result := make([]domain.Statement, len(fromBlocks))
 
 	for i, block := range fromBlocks {
-		statement, err := makeStatement(block)
+		statement, err := makeStatement(block, functions)
 		if err != nil {
-			return nil, nil
+			return nil, err
 		}
 
 		result[i] = statement
@@ -53,20 +141,29 @@ func mapToStatements(fromBlocks []ast.Block) ([]domain.Statement, error) {
 	return result, nil
 }
 
-func makeStatement(block ast.Block) (domain.Statement, error) {
+func makeStatement(block ast.Block, functions map[string]restql.FunctionPlugin) (domain.Statement, error) {
 	s := domain.Statement{
 		Method:   strings.TrimSpace(block.Method),
 		Resource: block.Resource,
 		Alias:    block.Alias,
 		In:       block.In,
 	}
+	if block.InBy != nil {
+		s.InBy = &domain.AggregationKey{Target: block.InBy.Target, Origin: block.InBy.Origin, DropUnmatched: block.InBy.Unmatched == "drop"}
+	}
+
 	for _, qualifier := range block.Qualifiers {
 		if qualifier.With != nil {
-			s.With = makeParams(qualifier)
+			params, err := makeParams(qualifier, functions)
+			if err != nil {
+				return domain.Statement{}, err
+			}
+
+			s.With = params
 		}
 
 		if qualifier.Only != nil {
-			filter, err := makeOnlyFilter(qualifier)
+			filter, err := makeOnlyFilter(qualifier, functions)
 			if err != nil {
 				return domain.Statement{}, err
 			}
@@ -74,6 +171,10 @@ func makeStatement(block ast.Block) (domain.Statement, error) {
 			s.Only = filter
 		}
 
+		if qualifier.Exclude != nil {
+			s.Exclude = qualifier.Exclude
+		}
+
 		if qualifier.Timeout != nil {
 			s.Timeout = makeTimeout(qualifier)
 		}
@@ -92,8 +193,24 @@ func makeStatement(block ast.Block) (domain.Statement, error) {
 			s.CacheControl.SMaxAge = value
 		}
 
-		if qualifier.DependsOn != "" {
-			s.DependsOn = domain.DependsOn{Target: qualifier.DependsOn}
+		if qualifier.DependsOn != nil {
+			s.DependsOn = domain.DependsOn{Targets: qualifier.DependsOn.Targets, Condition: qualifier.DependsOn.Condition}
+		}
+
+		if qualifier.When != nil {
+			s.When = makeCondition(qualifier)
+		}
+
+		if qualifier.Paginate != nil {
+			s.Paginate = makePagination(qualifier)
+		}
+
+		if qualifier.Retry != nil {
+			s.Retry = makeRetry(qualifier)
+		}
+
+		if qualifier.OnError != nil {
+			s.OnError = &domain.OnError{Default: getValue(qualifier.OnError.Default)}
 		}
 
 		s.Hidden = qualifier.Hidden || s.Hidden
@@ -103,12 +220,15 @@ func makeStatement(block ast.Block) (domain.Statement, error) {
 	return s, nil
 }
 
-func makeParams(wq ast.Qualifier) domain.Params {
+func makeParams(wq ast.Qualifier, functions map[string]restql.FunctionPlugin) (domain.Params, error) {
 	values := make(map[string]interface{})
 	for _, item := range wq.With.KeyValues {
 		v := getValue(item.Value)
 
-		v = applyFunctions(v, item.Functions)
+		v, err := applyFunctions(v, item.Functions, functions)
+		if err != nil {
+			return domain.Params{}, err
+		}
 
 		values[item.Key] = v
 	}
@@ -119,24 +239,39 @@ func makeParams(wq ast.Qualifier) domain.Params {
 
 	parameterBody := wq.With.Body
 	if parameterBody == nil {
-		return p
+		return p, nil
 	}
 
 	var body interface{}
 	body = domain.Variable{Target: parameterBody.Target}
+	if parameterBody.Required {
+		body = domain.RequiredVariable{Target: parameterBody.Target}
+	}
 
-	body = applyFunctions(body, parameterBody.Functions)
+	body, err := applyFunctions(body, parameterBody.Functions, functions)
+	if err != nil {
+		return domain.Params{}, err
+	}
 
 	p.Body = body
 
-	return p
+	return p, nil
 }
 
-func applyFunctions(v interface{}, functions []string) interface{} {
-	for _, fn := range functions {
-		switch fn {
+func applyFunctions(v interface{}, fns []ast.Function, functions map[string]restql.FunctionPlugin) (interface{}, error) {
+	for _, fn := range fns {
+		switch fn.Name {
 		case ast.NoMultiplex:
 			v = domain.NoMultiplex{Value: v}
+		case ast.Cross:
+			v = domain.Cross{Value: v}
+		case ast.Batch:
+			size := getFunctionArgument(fn, 0, nil)
+			if !isValidBatchSize(size) {
+				return nil, errors.Errorf("function batch expects a positive integer size, got %v", size)
+			}
+
+			v = domain.NewBatch(v, size)
 		case ast.AsBody:
 			v = domain.AsBody{Value: v}
 		case ast.Base64:
@@ -149,20 +284,102 @@ func applyFunctions(v interface{}, functions []string) interface{} {
 			v = domain.NoExplode{Value: v}
 		case ast.AsQuery:
 			v = domain.AsQuery{Value: v}
+		case ast.Upper:
+			v = domain.Upper{Value: v}
+		case ast.Lower:
+			v = domain.Lower{Value: v}
+		case ast.Trim:
+			v = domain.Trim{Value: v}
+		case ast.ToInt:
+			v = domain.ToInt{Value: v}
+		case ast.ToString:
+			v = domain.ToString{Value: v}
+		case ast.URLEncode:
+			v = domain.URLEncode{Value: v}
+		case ast.SHA256:
+			v = domain.SHA256{Value: v}
+		case ast.Join:
+			v = domain.NewJoin(v, getFunctionArgument(fn, 0, defaultSeparator))
+		case ast.Split:
+			v = domain.NewSplit(v, getFunctionArgument(fn, 0, defaultSeparator))
+		case ast.HMACSHA256:
+			secret := getFunctionArgument(fn, 0, nil)
+			if !isValidHMACSecret(secret) {
+				return nil, errors.Errorf("function hmac-sha256 expects a string, number or variable secret, got %v", secret)
+			}
+
+			v = domain.NewHMACSHA256(v, secret)
+		default:
+			pluginFn, err := makePluginFunction(v, fn, functions)
+			if err != nil {
+				return nil, err
+			}
+
+			v = pluginFn
 		}
 	}
 
-	return v
+	return v, nil
+}
+
+func makePluginFunction(target interface{}, fn ast.Function, functions map[string]restql.FunctionPlugin) (domain.PluginFunction, error) {
+	plugin, found := functions[fn.Name]
+	if !found {
+		return domain.PluginFunction{}, errors.Errorf("unknown function: %s", fn.Name)
+	}
+
+	args := make([]interface{}, len(fn.Arguments))
+	for i, a := range fn.Arguments {
+		args[i] = getValue(a)
+	}
+
+	return domain.NewPluginFunction(target, plugin, args), nil
+}
+
+const defaultSeparator = ","
+
+// isValidBatchSize accepts variables, which are only
+// checked when the query is executed.
+func isValidBatchSize(size interface{}) bool {
+	switch size := size.(type) {
+	case int:
+		return size > 0
+	case domain.Variable, domain.RequiredVariable, domain.DefaultVariable:
+		return true
+	default:
+		return false
+	}
+}
+
+// isValidHMACSecret accepts variables, which are only
+// checked when the query is executed.
+func isValidHMACSecret(secret interface{}) bool {
+	switch secret.(type) {
+	case string, int, float64:
+		return true
+	case domain.Variable, domain.RequiredVariable, domain.DefaultVariable:
+		return true
+	default:
+		return false
+	}
+}
+
+func getFunctionArgument(fn ast.Function, index int, defaultValue interface{}) interface{} {
+	if index >= len(fn.Arguments) {
+		return defaultValue
+	}
+
+	return getValue(fn.Arguments[index])
 }
 
-func makeOnlyFilter(onlyQualifier ast.Qualifier) ([]interface{}, error) {
+func makeOnlyFilter(onlyQualifier ast.Qualifier, functions map[string]restql.FunctionPlugin) ([]interface{}, error) {
 	filters := onlyQualifier.Only
 
 	result := make([]interface{}, len(filters))
 	for i, f := range filters {
 		var filter interface{} = f.Field
 		for _, fn := range f.Functions {
-			filterWithFunc, err := applyFunctionToFilter(filter, fn)
+			filterWithFunc, err := applyFunctionToFilter(filter, fn, functions)
 			if err != nil {
 				return nil, err
 			}
@@ -170,18 +387,36 @@ func makeOnlyFilter(onlyQualifier ast.Qualifier) ([]interface{}, error) {
 			filter = filterWithFunc
 		}
 
+		if f.Alias != "" {
+			filter = domain.Rename{Value: filter, Alias: f.Alias}
+		}
+
 		result[i] = filter
 	}
 
 	return result, nil
 }
 
-func applyFunctionToFilter(field, fn interface{}) (interface{}, error) {
+func applyFunctionToFilter(field, fn interface{}, functions map[string]restql.FunctionPlugin) (interface{}, error) {
 	switch fn := fn.(type) {
 	case ast.Match:
 		return makeMatchFunction(field, fn)
 	case ast.FilterByRegex:
 		return makeFilterByRegexFunction(field, fn)
+	case ast.SortBy:
+		return makeSortByFunction(field, fn), nil
+	case ast.Take:
+		return domain.NewTake(field, makeVariableValueOrInt(fn.Variable, fn.Int)), nil
+	case ast.Skip:
+		return domain.NewSkip(field, makeVariableValueOrInt(fn.Variable, fn.Int)), nil
+	case ast.Distinct:
+		return domain.NewDistinct(field, makeVariableOrString(fn.PathVariable, fn.PathString)), nil
+	case ast.IndexBy:
+		return domain.NewIndexBy(field, makeVariableOrString(fn.PathVariable, fn.PathString)), nil
+	case ast.GroupBy:
+		return domain.NewGroupBy(field, makeVariableOrString(fn.PathVariable, fn.PathString)), nil
+	case ast.Function:
+		return makePluginFunction(field, fn, functions)
 	default:
 		return field, nil
 	}
@@ -192,7 +427,7 @@ func makeFilterByRegexFunction(target interface{}, filterByRegexFn ast.FilterByR
 
 	switch {
 	case filterByRegexFn.PathVariable != nil:
-		path := domain.Variable{Target: *filterByRegexFn.PathVariable}
+		path := makeVariableValue(*filterByRegexFn.PathVariable)
 		fr = fr.SetArgument(domain.FilterByRegexArgPath, path)
 	case filterByRegexFn.PathString != nil:
 		fr = fr.SetArgument(domain.FilterByRegexArgPath, *filterByRegexFn.PathString)
@@ -200,7 +435,7 @@ func makeFilterByRegexFunction(target interface{}, filterByRegexFn ast.FilterByR
 
 	switch {
 	case filterByRegexFn.RegexVariable != nil:
-		regexVariable := domain.Variable{Target: *filterByRegexFn.RegexVariable}
+		regexVariable := makeVariableValue(*filterByRegexFn.RegexVariable)
 		fr = fr.SetArgument(domain.FilterByRegexArgRegex, regexVariable)
 	case filterByRegexFn.RegexString != nil:
 		reg, err := regexp.Compile(*filterByRegexFn.RegexString)
@@ -214,6 +449,50 @@ func makeFilterByRegexFunction(target interface{}, filterByRegexFn ast.FilterByR
 	return fr, nil
 }
 
+func makeSortByFunction(target interface{}, sortByFn ast.SortBy) domain.Function {
+	path := makeVariableOrString(sortByFn.PathVariable, sortByFn.PathString)
+
+	order := makeVariableOrString(sortByFn.OrderVariable, sortByFn.OrderString)
+	if order == nil {
+		order = domain.SortByOrderAsc
+	}
+
+	return domain.NewSortBy(target, path, order)
+}
+
+func makeVariableOrString(variable *ast.VariableValue, str *string) interface{} {
+	switch {
+	case variable != nil:
+		return makeVariableValue(*variable)
+	case str != nil:
+		return *str
+	default:
+		return nil
+	}
+}
+
+func makeVariableOrInt(variable *string, i *int) interface{} {
+	switch {
+	case variable != nil:
+		return domain.Variable{Target: *variable}
+	case i != nil:
+		return *i
+	default:
+		return nil
+	}
+}
+
+func makeVariableValueOrInt(variable *ast.VariableValue, i *int) interface{} {
+	switch {
+	case variable != nil:
+		return makeVariableValue(*variable)
+	case i != nil:
+		return *i
+	default:
+		return nil
+	}
+}
+
 func makeMatchFunction(target interface{}, matchFn ast.Match) (domain.Function, error) {
 	var match domain.Function = domain.Match{Value: target}
 
@@ -230,7 +509,7 @@ func makeMatchFunction(target interface{}, matchFn ast.Match) (domain.Function,
 	}
 
 	if matchFn.Variable != nil {
-		match = match.SetArgument(domain.MatchArgRegex, domain.Variable{Target: *matchFn.Variable})
+		match = match.SetArgument(domain.MatchArgRegex, makeVariableValue(*matchFn.Variable))
 
 		return match, nil
 	}
@@ -250,12 +529,16 @@ func makeHeaders(qualifier ast.Qualifier) map[string]interface{} {
 		}
 
 		if v.Variable != nil {
-			result[k] = domain.Variable{Target: *v.Variable}
+			result[k] = makeVariable(*v.Variable, v.Required, v.Default)
 		}
 
 		if v.Chain != nil {
 			result[k] = makeChain(v.Chain)
 		}
+
+		if v.Template != nil {
+			result[k] = makeTemplate(v.Template)
+		}
 	}
 
 	return result
@@ -300,9 +583,43 @@ func makeMaxAge(qualifier ast.Qualifier) interface{} {
 	return nil
 }
 
+func makeCondition(qualifier ast.Qualifier) *domain.Condition {
+	c := qualifier.When
+
+	condition := domain.Condition{Left: getValue(c.Left)}
+	if c.Right != nil {
+		condition.Operator = c.Operator
+		condition.Right = getValue(*c.Right)
+	}
+
+	return &condition
+}
+
+func makePagination(qualifier ast.Qualifier) *domain.Pagination {
+	p := qualifier.Paginate
+
+	pagination := domain.Pagination{Param: p.Param, Path: p.Path}
+	if p.MaxPages != nil {
+		pagination.MaxPages = makeVariableOrInt(p.MaxPages.Variable, p.MaxPages.Int)
+	}
+
+	return &pagination
+}
+
+func makeRetry(qualifier ast.Qualifier) *domain.Retry {
+	r := qualifier.Retry
+
+	retry := domain.Retry{Attempts: makeVariableOrInt(r.Attempts.Variable, r.Attempts.Int), Unsafe: r.Unsafe}
+	if r.Backoff != nil {
+		retry.Backoff = makeVariableOrInt(r.Backoff.Variable, r.Backoff.Int)
+	}
+
+	return &retry
+}
+
 func getValue(value ast.Value) interface{} {
 	if value.Variable != nil {
-		return domain.Variable{Target: *value.Variable}
+		return makeVariable(*value.Variable, value.Required, value.Default)
 	}
 
 	if value.Primitive != nil {
@@ -325,6 +642,22 @@ func getValue(value ast.Value) interface{} {
 	return nil
 }
 
+func makeVariable(target string, required bool, defaultValue *ast.Value) interface{} {
+	if required {
+		return domain.RequiredVariable{Target: target}
+	}
+
+	if defaultValue != nil {
+		return domain.DefaultVariable{Target: target, Default: getValue(*defaultValue)}
+	}
+
+	return domain.Variable{Target: target}
+}
+
+func makeVariableValue(v ast.VariableValue) interface{} {
+	return makeVariable(v.Name, v.Required, v.Default)
+}
+
 func getMap(entries []ast.ObjectEntry) map[string]interface{} {
 	result := map[string]interface{}{}
 
@@ -360,9 +693,28 @@ func getPrimitive(primitive *ast.Primitive) interface{} {
 		return makeChain(primitive.Chain)
 	}
 
+	if primitive.Template != nil {
+		return makeTemplate(primitive.Template)
+	}
+
 	return nil
 }
 
+func makeTemplate(parts []ast.TemplatePart) domain.Template {
+	result := make(domain.Template, len(parts))
+	for i, part := range parts {
+		switch {
+		case part.Text != nil:
+			result[i] = *part.Text
+		case part.Variable != nil:
+			result[i] = makeVariableValue(*part.Variable)
+		case part.Chain != nil:
+			result[i] = makeChain(part.Chain)
+		}
+	}
+	return result
+}
+
 func makeChain(chainedValue []ast.Chained) domain.Chain {
 	result := make(domain.Chain, len(chainedValue))
 	for i, chained := range chainedValue {
@@ -4,29 +4,51 @@ import (
 	"errors"
 	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
 	"github.com/b2wdigital/restQL-golang/v6/internal/parser/ast"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
 )
 
 // ErrInvalidQuery represents a given query that not comply with the restQL syntax
 var ErrInvalidQuery = errors.New("invalid query")
 
 // Parser is the interface implemented by types that
-// can transform a query string into an internal representation.
+// can transform a query string into an internal representation
+// and rewrite it in the canonical layout.
 type Parser interface {
 	Parse(queryStr string) (domain.Query, error)
+	Format(queryStr string) (string, error)
+}
+
+// Option is a parser parameter configurator
+type Option func(p *parser)
+
+// WithFunctions registers function plugins, making
+// their names available to the apply operator.
+func WithFunctions(plugins ...restql.FunctionPlugin) Option {
+	return func(p *parser) {
+		for _, fp := range plugins {
+			p.functions[fp.Name()] = fp
+		}
+	}
 }
 
 type parser struct {
 	astGenerator ast.Generator
+	functions    map[string]restql.FunctionPlugin
 }
 
 // New returns an instance of a Parser.
-func New() (Parser, error) {
+func New(options ...Option) (Parser, error) {
 	generator, err := ast.New()
 	if err != nil {
 		return parser{}, err
 	}
 
-	return parser{astGenerator: generator}, nil
+	p := parser{astGenerator: generator, functions: make(map[string]restql.FunctionPlugin)}
+	for _, opt := range options {
+		opt(&p)
+	}
+
+	return p, nil
 }
 
 func (p parser) Parse(queryStr string) (domain.Query, error) {
@@ -35,5 +57,23 @@ func (p parser) Parse(queryStr string) (domain.Query, error) {
 		return domain.Query{}, err
 	}
 
-	return Optimize(query)
+	return Optimize(query, p.functions)
+}
+
+// Format parses the query and prints it back using
+// the canonical layout defined by ast.Print.
+// The query is validated as in Parse, hence it can
+// only apply the registered function plugins.
+func (p parser) Format(queryStr string) (string, error) {
+	query, err := p.astGenerator.Parse(queryStr)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = Optimize(query, p.functions)
+	if err != nil {
+		return "", err
+	}
+
+	return ast.Print(query), nil
 }
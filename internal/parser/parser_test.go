@@ -6,6 +6,7 @@ import (
 
 	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
 	"github.com/b2wdigital/restQL-golang/v6/internal/parser"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
 	"github.com/b2wdigital/restQL-golang/v6/test"
 )
 
@@ -177,6 +178,23 @@ func TestQueryParser(t *testing.T) {
 					from sidekick in hero.sidekick
 			`,
 		},
+		{
+			"Query with params declaration",
+			domain.Query{
+				Use: map[string]interface{}{"max-age": 600},
+				Params: []domain.Param{
+					{Name: "id", Type: domain.IntParam, Required: true},
+					{Name: "page", Type: domain.IntParam, Default: 1},
+					{Name: "ratio", Type: domain.FloatParam, Default: 1.0},
+					{Name: "sort", Type: domain.EnumParam, Enum: []string{"asc", "desc"}, Default: "asc"},
+					{Name: "tags", Type: domain.ListParam, Default: []interface{}{"a"}},
+				},
+				Statements: []domain.Statement{{Method: "from", Resource: "hero"}},
+			},
+			`use params {id: int required, page: int = 1, ratio: float = 1, sort: enum("asc", "desc") = "asc", tags: list = "a"}
+			use max-age 600
+			from hero`,
+		},
 		{
 			"Full query",
 			domain.Query{
@@ -256,7 +274,7 @@ func TestQueryParser(t *testing.T) {
 			"Multiple statements with second using depends on first",
 			domain.Query{Statements: []domain.Statement{
 				{Method: "from", Resource: "hero"},
-				{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Target: "hero"}},
+				{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Targets: []string{"hero"}}},
 			}},
 			`
 					from hero
@@ -264,6 +282,20 @@ func TestQueryParser(t *testing.T) {
 						depends-on hero
 			`,
 		},
+		{
+			"Statement depending on multiple statements with condition",
+			domain.Query{Statements: []domain.Statement{
+				{Method: "to", Resource: "payment"},
+				{Method: "to", Resource: "reserve"},
+				{Method: "delete", Resource: "order", DependsOn: domain.DependsOn{Targets: []string{"payment", "reserve"}, Condition: domain.DependsOnFailure}},
+			}},
+			`
+					to payment
+					to reserve
+					delete order
+						depends-on [payment, reserve] on failure
+			`,
+		},
 		{
 			"Unique from statement and only filters with filterByRegex function",
 			domain.Query{Statements: []domain.Statement{{
@@ -317,6 +349,148 @@ func TestQueryParser(t *testing.T) {
 			domain.Query{Statements: []domain.Statement{{Method: "to", Resource: "hero", With: domain.Params{Values: map[string]interface{}{"id": 1, "context": domain.AsQuery{Value: "crossover"}}}}}},
 			`to hero with id = 1, context = "crossover" -> as-query`,
 		},
+		{
+			"Statement with when clause",
+			domain.Query{Statements: []domain.Statement{{
+				Method:   "from",
+				Resource: "loyalty",
+				With:     domain.Params{Values: map[string]interface{}{"id": domain.Chain{"customer", "id"}}},
+				When:     &domain.Condition{Left: domain.Variable{Target: "logged"}, Operator: domain.EqualOperator, Right: true},
+			}}},
+			`from loyalty when $logged = true with id = customer.id`,
+		},
+		{
+			"Statement with when clause checking existence",
+			domain.Query{Statements: []domain.Statement{{
+				Method:   "from",
+				Resource: "loyalty",
+				When:     &domain.Condition{Left: domain.Chain{"customer", "loyaltyId"}},
+			}}},
+			`from loyalty when customer.loyaltyId`,
+		},
+		{
+			"Statement with required variables on when clause and body",
+			domain.Query{Statements: []domain.Statement{{
+				Method:   "to",
+				Resource: "loyalty",
+				With:     domain.Params{Values: map[string]interface{}{}, Body: domain.RequiredVariable{Target: "payload"}},
+				When:     &domain.Condition{Left: domain.RequiredVariable{Target: "tier"}, Operator: domain.NotEqualOperator, Right: "guest"},
+			}}},
+			`to loyalty when $tier! != "guest" with $payload!`,
+		},
+		{
+			"Statement with not equal when clause without spaces",
+			domain.Query{Statements: []domain.Statement{{
+				Method:   "from",
+				Resource: "loyalty",
+				When:     &domain.Condition{Left: domain.Variable{Target: "tier"}, Operator: domain.NotEqualOperator, Right: "guest"},
+			}}},
+			`from loyalty when $tier!="guest"`,
+		},
+		{
+			"Query with include using query name as default alias",
+			domain.Query{
+				Include:    []domain.Include{{Namespace: "common", Query: "checkout", Revision: 3, Alias: "checkout"}},
+				Statements: []domain.Statement{},
+			},
+			`include common/checkout/3`,
+		},
+		{
+			"Unique from statement with required and default variables",
+			domain.Query{Statements: []domain.Statement{{
+				Method:   "from",
+				Resource: "hero",
+				Headers:  map[string]interface{}{"Authorization": domain.RequiredVariable{Target: "token"}},
+				With: domain.Params{Values: map[string]interface{}{
+					"id":    domain.RequiredVariable{Target: "id"},
+					"page":  domain.DefaultVariable{Target: "page", Default: 1},
+					"names": []interface{}{domain.DefaultVariable{Target: "name", Default: "batman"}},
+				}},
+			}}},
+			`from hero headers Authorization = $token! with id = $id!, page = $page ?: 1, names = [$name ?: "batman"]`,
+		},
+		{
+			"Unique from statement and only filters renaming fields",
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "hero", Only: []interface{}{
+				domain.Rename{Value: []string{"name"}, Alias: "heroName"},
+				domain.Rename{Value: domain.Match{Value: []string{"weapons", "name"}, Args: []domain.Arg{{Name: domain.MatchArgRegex, Value: domain.Variable{Target: "weapon"}}}}, Alias: "weaponName"},
+			}}}},
+			`from hero only name as heroName, weapons.name -> matches($weapon) as weaponName`,
+		},
+		{
+			"Unique from statement and only filters with list functions",
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "product", Only: []interface{}{
+				domain.Rename{Value: domain.NewTake(domain.NewSortBy([]string{"offers"}, "price", "desc"), domain.Variable{Target: "size"}), Alias: "bestOffers"},
+				domain.NewDistinct(domain.NewSkip([]string{"sellers"}, 2), nil),
+			}}}},
+			`from product only offers -> sort-by("price", "desc") -> take($size) as bestOffers, sellers -> skip(2) -> distinct()`,
+		},
+		{
+			"Unique from statement with pagination",
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "product", Paginate: &domain.Pagination{Param: "cursor", Path: []string{"result", "nextCursor"}, MaxPages: 5}}}},
+			`from product paginate cursor = result.nextCursor max 5`,
+		},
+		{
+			"Unique from statement with retry",
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "hero", Retry: &domain.Retry{Attempts: 2, Backoff: 50}}}},
+			`from hero retry 2 backoff 50`,
+		},
+		{
+			"Unique to statement with unsafe retry",
+			domain.Query{Statements: []domain.Statement{{Method: "to", Resource: "hero", Retry: &domain.Retry{Attempts: 2, Unsafe: true}}}},
+			`to hero retry 2 unsafe`,
+		},
+		{
+			"Unique from statement with on-error default value",
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "hero", OnError: &domain.OnError{Default: map[string]interface{}{"items": []interface{}{}}}, IgnoreErrors: true}}},
+			`from hero on-error default {items: []} ignore-errors`,
+		},
+		{
+			"Unique from statement with template strings",
+			domain.Query{Statements: []domain.Statement{{
+				Method:   "from",
+				Resource: "hero",
+				Headers:  map[string]interface{}{"Authorization": domain.Template{"Bearer ", domain.Variable{Target: "token"}}},
+				With:     domain.Params{Values: map[string]interface{}{"path": domain.Template{"/v2/", domain.Variable{Target: "region"}, "/", domain.Chain{"done-resource", "id"}}}},
+			}}},
+			`from hero headers Authorization = "Bearer ${$token}" with path = "/v2/${$region}/${done-resource.id}"`,
+		},
+		{
+			"Unique from statement with required and default variables in templates and only functions",
+			domain.Query{Statements: []domain.Statement{{
+				Method:   "from",
+				Resource: "hero",
+				Headers:  map[string]interface{}{"Authorization": domain.Template{"Bearer ", domain.RequiredVariable{Target: "token"}}},
+				With:     domain.Params{Values: map[string]interface{}{"path": domain.Template{"/", domain.DefaultVariable{Target: "region", Default: "br"}}}},
+				Only: []interface{}{
+					domain.NewTake(domain.NewSortBy([]string{"offers"}, domain.DefaultVariable{Target: "sortField", Default: "price"}, "desc"), domain.RequiredVariable{Target: "size"}),
+					domain.Match{Value: []string{"name"}, Args: []domain.Arg{{Name: domain.MatchArgRegex, Value: domain.RequiredVariable{Target: "pattern"}}}},
+				},
+			}}},
+			`from hero headers Authorization = "Bearer ${$token!}" with path = "/${$region ?: "br"}" only offers -> sort-by($sortField ?: "price", "desc") -> take($size!), name -> matches($pattern!)`,
+		},
+		{
+			"Unique from statement with parameter functions",
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "hero", With: domain.Params{Values: map[string]interface{}{
+				"ids":       domain.Upper{Value: domain.NewJoin(domain.Variable{Target: "ids"}, ",")},
+				"signature": domain.NewHMACSHA256(domain.Variable{Target: "payload"}, domain.Variable{Target: "secret"}),
+				"code":      domain.URLEncode{Value: domain.ToString{Value: domain.SHA256{Value: domain.Lower{Value: domain.Trim{Value: domain.ToInt{Value: domain.NewSplit("1|2", "|")}}}}}},
+			}}}}},
+			`from hero with ids = $ids -> join -> upper, signature = $payload -> hmac-sha256($secret), code = "1|2" -> split("|") -> to-int -> trim -> lower -> sha256 -> to-string -> url-encode`,
+		},
+		{
+			"Unique from statement with index-by and group-by functions",
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "product", Only: []interface{}{
+				domain.NewIndexBy([]string{"skus"}, "id"),
+				domain.NewGroupBy([]string{"offers"}, domain.Variable{Target: "groupField"}),
+			}}}},
+			`from product only skus -> index-by("id"), offers -> group-by($groupField)`,
+		},
+		{
+			"Unique from statement with exclude clause",
+			domain.Query{Statements: []domain.Statement{{Method: "from", Resource: "product", Exclude: [][]string{{"description"}, {"seller", "audit"}}}}},
+			`from product exclude description, seller.audit`,
+		},
 	}
 
 	queryParser, err := parser.New()
@@ -332,6 +506,194 @@ func TestQueryParser(t *testing.T) {
 	}
 }
 
+type stubFunctionPlugin string
+
+func (s stubFunctionPlugin) Name() string {
+	return string(s)
+}
+
+func (s stubFunctionPlugin) Type() restql.PluginType {
+	return restql.FunctionPluginType
+}
+
+func (s stubFunctionPlugin) Apply(log restql.Logger, value interface{}, args restql.FunctionArguments) (interface{}, error) {
+	return value, nil
+}
+
+func TestQueryParserWithFunctionPlugins(t *testing.T) {
+	mask := stubFunctionPlugin("mask")
+	currency := stubFunctionPlugin("to_currency")
+
+	queryParser, err := parser.New(parser.WithFunctions(mask, currency))
+	test.VerifyError(t, err)
+
+	t.Run("should bind registered functions on parameters and filters", func(t *testing.T) {
+		query := `from hero with document = $document -> mask("*", 4) -> upper only name, price -> to_currency($locale) -> take(1)`
+
+		expected := domain.Query{Statements: []domain.Statement{{
+			Method:   "from",
+			Resource: "hero",
+			With: domain.Params{Values: map[string]interface{}{
+				"document": domain.Upper{Value: domain.NewPluginFunction(domain.Variable{Target: "document"}, mask, []interface{}{"*", 4})},
+			}},
+			Only: []interface{}{
+				[]string{"name"},
+				domain.NewTake(domain.NewPluginFunction([]string{"price"}, currency, []interface{}{domain.Variable{Target: "locale"}}), 1),
+			},
+		}}}
+
+		got, err := queryParser.Parse(query)
+
+		test.VerifyError(t, err)
+		test.Equal(t, got, expected)
+	})
+
+	t.Run("should fail when function is not registered", func(t *testing.T) {
+		_, err := queryParser.Parse(`from hero with id = $id -> unknown`)
+
+		test.Equal(t, err.Error(), "unknown function: unknown")
+	})
+
+	t.Run("should fail when built-in function receives wrong arguments", func(t *testing.T) {
+		_, err := queryParser.Parse(`from hero with id = $id -> upper("x")`)
+
+		test.NotEqual(t, err, nil)
+	})
+
+	t.Run("should parse batch function with literal or variable size", func(t *testing.T) {
+		expected := domain.Query{Statements: []domain.Statement{{
+			Method:   "from",
+			Resource: "hero",
+			With: domain.Params{Values: map[string]interface{}{
+				"id":   domain.NewBatch(domain.Variable{Target: "ids"}, 50),
+				"name": domain.NewBatch(domain.Variable{Target: "names"}, domain.Variable{Target: "size"}),
+			}},
+		}}}
+
+		got, err := queryParser.Parse(`from hero with id = $ids -> batch(50), name = $names -> batch($size)`)
+
+		test.VerifyError(t, err)
+		test.Equal(t, got, expected)
+	})
+
+	t.Run("should parse cross multiplexing", func(t *testing.T) {
+		expected := domain.Query{
+			Use: map[string]interface{}{"multiplex": "cross"},
+			Statements: []domain.Statement{{
+				Method:   "from",
+				Resource: "quote",
+				With: domain.Params{Values: map[string]interface{}{
+					"seller": domain.Cross{Value: domain.Variable{Target: "sellers"}},
+					"zip":    domain.Variable{Target: "zips"},
+				}},
+			}},
+		}
+
+		got, err := queryParser.Parse("use multiplex cross\nfrom quote with seller = $sellers -> cross, zip = $zips")
+
+		test.VerifyError(t, err)
+		test.Equal(t, got, expected)
+	})
+
+	t.Run("should parse in clause with aggregation key", func(t *testing.T) {
+		expected := domain.Query{
+			Statements: []domain.Statement{
+				{Method: "from", Resource: "hero"},
+				{
+					Method:   "from",
+					Resource: "sidekick",
+					In:       []string{"hero", "items", "sidekick"},
+					InBy:     &domain.AggregationKey{Target: []string{"id"}, Origin: []string{"hero", "id"}, DropUnmatched: true},
+					With:     domain.Params{Values: map[string]interface{}{"heroId": domain.Chain{"hero", "items", "id"}}},
+				},
+			},
+		}
+
+		got, err := queryParser.Parse("from hero\nfrom sidekick in hero.items.sidekick by id = hero.id unmatched drop with heroId = hero.items.id")
+
+		test.VerifyError(t, err)
+		test.Equal(t, got, expected)
+	})
+
+	t.Run("should fail when batch size is not a positive integer", func(t *testing.T) {
+		_, err := queryParser.Parse(`from hero with id = $ids -> batch(0)`)
+
+		test.Equal(t, err.Error(), "function batch expects a positive integer size, got 0")
+	})
+
+	t.Run("should fail when hmac-sha256 secret is not a string, number or variable", func(t *testing.T) {
+		_, err := queryParser.Parse(`from hero with signature = $payload -> hmac-sha256(done-resource.secret)`)
+
+		test.Equal(t, err.Error(), "function hmac-sha256 expects a string, number or variable secret, got [done-resource secret]")
+	})
+
+	t.Run("should fail when param default value does not match its type", func(t *testing.T) {
+		_, err := queryParser.Parse(`use params {page: int = "first"} from hero`)
+
+		test.NotEqual(t, err, nil)
+	})
+}
+
+func TestFormat(t *testing.T) {
+	queryParser, err := parser.New()
+	test.VerifyError(t, err)
+
+	t.Run("should produce a query equivalent to the original", func(t *testing.T) {
+		query := `use params {id: int required, page: int = 1, sort: enum("asc", "desc")}
+use multiplex cross
+use max-age 600
+use timeout 1000
+include common/checkout/3 as ck
+
+from hero as h headers Authorization = "Bearer ${$token}", X-Trace = $trace! timeout $timeout max-age 100 s-max-age 50
+	with id = $id -> base64, names = [$name ?: "batman", "robin"] -> no-multiplex, filter = {score: 1.5, tags: ["a\tb"], active: true}
+	only name -> matches("^b"), skus -> index-by("id") as byId, offers -> sort-by($field, "desc") -> take(2) -> skip(1)
+
+from sidekick in hero.sidekick by id = heroId unmatched null depends-on hero when hero.active != false paginate page = result.next max 3 retry 2 backoff 10 on-error default {items: []}
+	with id = hero.$field -> join(","), path = "/v2/${hero.id}/list"
+	exclude weapons.damage, powers ignore-errors
+
+to villain when $tier! retry 1 backoff 5 unsafe with $body! -> no-multiplex, name = "joker", ids = $ids -> cross hidden
+
+delete villain as refund depends-on [hero, villain] on success ignore-errors`
+
+		expected, err := queryParser.Parse(query)
+		test.VerifyError(t, err)
+
+		formatted, err := queryParser.Format(query)
+		test.VerifyError(t, err)
+
+		got, err := queryParser.Parse(formatted)
+		test.VerifyError(t, err)
+		test.Equal(t, got, expected)
+
+		reformatted, err := queryParser.Format(formatted)
+		test.VerifyError(t, err)
+		test.Equal(t, reformatted, formatted)
+	})
+
+	t.Run("should fail when query is invalid", func(t *testing.T) {
+		_, err := queryParser.Format(`from hero wiht id = 1`)
+
+		test.NotEqual(t, err, nil)
+	})
+
+	t.Run("should fail when query applies an unregistered function", func(t *testing.T) {
+		_, err := queryParser.Format(`from hero with document = $document -> mask("*", 4)`)
+
+		test.Equal(t, err.Error(), "unknown function: mask")
+	})
+
+	t.Run("should format query applying registered function plugins", func(t *testing.T) {
+		pluginParser, err := parser.New(parser.WithFunctions(stubFunctionPlugin("mask")))
+		test.VerifyError(t, err)
+
+		formatted, err := pluginParser.Format(`from hero with document = $document -> mask("*", 4) only name`)
+		test.VerifyError(t, err)
+		test.Equal(t, formatted, "from hero\n    with\n        document = $document -> mask(\"*\", 4)\n    only\n        name\n")
+	})
+}
+
 func BenchmarkParse(b *testing.B) {
 	query := `
 from hero as h
@@ -0,0 +1,526 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+
+	"github.com/bluele/gcache"
+)
+
+// Status codes that can be stored when the response
+// has explicit freshness information or a validator.
+var cacheableStatusCodes = map[int]bool{
+	http.StatusOK:                   true,
+	http.StatusNonAuthoritativeInfo: true,
+	http.StatusNoContent:            true,
+	http.StatusMultipleChoices:      true,
+	http.StatusMovedPermanently:     true,
+	http.StatusNotFound:             true,
+	http.StatusMethodNotAllowed:     true,
+	http.StatusGone:                 true,
+	http.StatusRequestURITooLong:    true,
+	http.StatusNotImplemented:       true,
+}
+
+// HTTPClientCacheOptions represents the parameters
+// of the HTTP response cache.
+// MaxSize is the maximum number of stored responses,
+// which must be greater than zero.
+// MaxEntrySize is the maximum body size in bytes of
+// a stored response, 0 disabling the limit.
+// KeyHeaders are the request headers, besides the
+// method, URL and tenant, used to identify an entry.
+type HTTPClientCacheOptions struct {
+	MaxSize      int
+	MaxEntrySize int
+	KeyHeaders   []string
+}
+
+// HTTPClientCache is a caching wrapper that implements the
+// HTTPClient interface. It stores upstream responses to GET
+// requests and reuses them following the rules of RFC 7234
+// for shared caches, revalidating stale entries and
+// serving them when the upstream fails if the response
+// allows it through the `stale-if-error` directive.
+type HTTPClientCache struct {
+	log          restql.Logger
+	client       domain.HTTPClient
+	gcache       gcache.Cache
+	maxEntrySize int
+	keyHeaders   []string
+
+	mu      sync.Mutex
+	targets map[string]map[string]bool
+}
+
+// NewHTTPClientCache constructs a HTTPClientCache instance.
+func NewHTTPClientCache(log restql.Logger, client domain.HTTPClient, options HTTPClientCacheOptions) (*HTTPClientCache, error) {
+	if options.MaxSize <= 0 {
+		return nil, fmt.Errorf("invalid http cache max size %d: it must be greater than zero", options.MaxSize)
+	}
+
+	c := &HTTPClientCache{
+		log:          log,
+		client:       client,
+		maxEntrySize: options.MaxEntrySize,
+		keyHeaders:   options.KeyHeaders,
+		targets:      make(map[string]map[string]bool),
+	}
+
+	c.gcache = gcache.New(options.MaxSize).
+		LRU().
+		AddedFunc(c.indexEntry).
+		EvictedFunc(c.unindexEntry).
+		Build()
+
+	return c, nil
+}
+
+// Do returns a stored response for the request if it is fresh,
+// otherwise it executes the request with the wrapped client,
+// revalidating the stored response when possible.
+func (c *HTTPClientCache) Do(ctx context.Context, request restql.HTTPRequest) (restql.HTTPResponse, error) {
+	log := restql.GetLogger(ctx)
+
+	if request.Method != http.MethodGet {
+		response, err := c.client.Do(ctx, request)
+		if err == nil && response.StatusCode >= 200 && response.StatusCode <= 399 {
+			c.invalidate(requestTarget(request))
+		}
+		return response, err
+	}
+
+	requestDirectives := parseCacheDirectives(findHeader(request.Headers, "Cache-Control"))
+	if requestDirectives.noStore {
+		return c.client.Do(ctx, request)
+	}
+
+	start := time.Now()
+	key := c.key(ctx, request)
+	entry, found := c.get(key, request)
+	if found && !requestDirectives.noCache && entry.isFresh(start) {
+		log.Debug("upstream response served from cache", "url", entry.url)
+		return entry.response(c.log, start), nil
+	}
+
+	upstreamRequest := request
+	if found {
+		upstreamRequest = withValidators(request, entry)
+	}
+
+	response, err := c.client.Do(ctx, upstreamRequest)
+	switch {
+	case found && err == nil && response.StatusCode == http.StatusNotModified:
+		entry = entry.revalidate(response, time.Now())
+		c.set(key, entry)
+
+		log.Debug("cached upstream response revalidated", "url", entry.url)
+		return entry.response(c.log, start), nil
+	case found && (err != nil || response.StatusCode >= 500) && entry.canServeStaleOnError(time.Now(), requestDirectives):
+		log.Debug("stale upstream response served from cache due to upstream failure", "url", entry.url, "error", err, "status", response.StatusCode)
+		return entry.response(c.log, start), nil
+	case err != nil:
+		return response, err
+	}
+
+	if newEntry, ok := c.newEntry(request, response, time.Now()); ok {
+		c.set(key, newEntry)
+	} else if found && response.StatusCode < 500 {
+		c.gcache.Remove(key)
+	}
+
+	return response, nil
+}
+
+func (c *HTTPClientCache) key(ctx context.Context, request restql.HTTPRequest) string {
+	var b strings.Builder
+
+	b.WriteString(domain.GetTenant(ctx))
+	b.WriteString("\n")
+	b.WriteString(request.Method)
+	b.WriteString(" ")
+	b.WriteString(requestTarget(request))
+
+	queryKeys := make([]string, 0, len(request.Query))
+	for k := range request.Query {
+		queryKeys = append(queryKeys, k)
+	}
+	sort.Strings(queryKeys)
+
+	for i, k := range queryKeys {
+		if i == 0 {
+			b.WriteString("?")
+		} else {
+			b.WriteString("&")
+		}
+		b.WriteString(k)
+		b.WriteString("=")
+		b.WriteString(fmt.Sprintf("%v", request.Query[k]))
+	}
+
+	for _, h := range c.keyHeaders {
+		b.WriteString("\n")
+		b.WriteString(strings.ToLower(h))
+		b.WriteString(":")
+		b.WriteString(findHeader(request.Headers, h))
+	}
+
+	return b.String()
+}
+
+func (c *HTTPClientCache) get(key string, request restql.HTTPRequest) (httpCacheEntry, bool) {
+	obj, err := c.gcache.Get(key)
+	if err != nil {
+		return httpCacheEntry{}, false
+	}
+
+	entry, ok := obj.(httpCacheEntry)
+	if !ok {
+		return httpCacheEntry{}, false
+	}
+
+	for name, value := range entry.vary {
+		if findHeader(request.Headers, name) != value {
+			return httpCacheEntry{}, false
+		}
+	}
+
+	return entry, true
+}
+
+func (c *HTTPClientCache) set(key string, entry httpCacheEntry) {
+	err := c.gcache.Set(key, entry)
+	if err != nil {
+		c.log.Error("failed to set upstream response on cache", err)
+	}
+}
+
+// invalidate removes every entry with the given target, as
+// required after a successful request with an unsafe method.
+// The keys are copied before removing them, since the index
+// is updated by the cache while removing each one.
+func (c *HTTPClientCache) invalidate(target string) {
+	c.mu.Lock()
+	keys := make([]string, 0, len(c.targets[target]))
+	for key := range c.targets[target] {
+		keys = append(keys, key)
+	}
+	c.mu.Unlock()
+
+	for _, key := range keys {
+		c.gcache.Remove(key)
+	}
+}
+
+// indexEntry keeps track of the keys stored for each target,
+// in order to find them on invalidation without walking the
+// whole cache. It is called by the cache on every store.
+func (c *HTTPClientCache) indexEntry(key, value interface{}) {
+	entry, ok := value.(httpCacheEntry)
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	keys, found := c.targets[entry.target]
+	if !found {
+		keys = make(map[string]bool)
+		c.targets[entry.target] = keys
+	}
+	keys[key.(string)] = true
+}
+
+// unindexEntry is called by the cache when an entry is
+// removed, be it by eviction or explicitly.
+func (c *HTTPClientCache) unindexEntry(key, value interface{}) {
+	entry, ok := value.(httpCacheEntry)
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	keys := c.targets[entry.target]
+	delete(keys, key.(string))
+	if len(keys) == 0 {
+		delete(c.targets, entry.target)
+	}
+}
+
+func (c *HTTPClientCache) newEntry(request restql.HTTPRequest, response restql.HTTPResponse, now time.Time) (httpCacheEntry, bool) {
+	if !cacheableStatusCodes[response.StatusCode] {
+		return httpCacheEntry{}, false
+	}
+
+	var body []byte
+	if response.Body != nil {
+		body = response.Body.Bytes()
+	}
+	if c.maxEntrySize > 0 && len(body) > c.maxEntrySize {
+		return httpCacheEntry{}, false
+	}
+
+	entry := httpCacheEntry{
+		url:        response.URL,
+		target:     requestTarget(request),
+		statusCode: response.StatusCode,
+		headers:    copyHeaders(response.Headers),
+		body:       body,
+	}
+	entry = entry.withFreshness(now)
+
+	d := entry.directives
+	if d.noStore || d.private {
+		return httpCacheEntry{}, false
+	}
+
+	if findHeader(request.Headers, "Authorization") != "" && !d.public && !d.mustRevalidate && d.sMaxAge < 0 {
+		return httpCacheEntry{}, false
+	}
+
+	if entry.freshness <= 0 && !entry.hasValidator() && d.staleIfError <= 0 {
+		return httpCacheEntry{}, false
+	}
+
+	vary := findHeader(response.Headers, "Vary")
+	for _, name := range strings.Split(vary, ",") {
+		name = strings.TrimSpace(name)
+		switch name {
+		case "":
+			continue
+		case "*":
+			return httpCacheEntry{}, false
+		}
+
+		if entry.vary == nil {
+			entry.vary = make(map[string]string)
+		}
+		entry.vary[name] = findHeader(request.Headers, name)
+	}
+
+	return entry, true
+}
+
+// httpCacheEntry is a stored upstream response.
+// Entries are never changed once stored, being
+// replaced by a new value instead.
+type httpCacheEntry struct {
+	url        string
+	target     string
+	statusCode int
+	headers    restql.Headers
+	body       []byte
+	vary       map[string]string
+	directives cacheDirectives
+	storedAt   time.Time
+	initialAge time.Duration
+	freshness  time.Duration
+}
+
+// withFreshness computes the freshness lifetime and the age
+// of the entry, considering it was received at the given time.
+func (e httpCacheEntry) withFreshness(now time.Time) httpCacheEntry {
+	e.directives = parseCacheDirectives(findHeader(e.headers, "Cache-Control"))
+	e.storedAt = now
+	e.initialAge = 0
+	e.freshness = 0
+
+	if age, err := strconv.Atoi(findHeader(e.headers, "Age")); err == nil && age > 0 {
+		e.initialAge = time.Duration(age) * time.Second
+	}
+
+	date, dateErr := http.ParseTime(findHeader(e.headers, "Date"))
+	if dateErr == nil && now.Sub(date) > e.initialAge {
+		e.initialAge = now.Sub(date)
+	}
+
+	switch {
+	case e.directives.sMaxAge >= 0:
+		e.freshness = time.Duration(e.directives.sMaxAge) * time.Second
+	case e.directives.maxAge >= 0:
+		e.freshness = time.Duration(e.directives.maxAge) * time.Second
+	default:
+		expires, err := http.ParseTime(findHeader(e.headers, "Expires"))
+		if err != nil {
+			break
+		}
+
+		if dateErr != nil {
+			date = now
+		}
+		e.freshness = expires.Sub(date)
+	}
+
+	return e
+}
+
+func (e httpCacheEntry) age(now time.Time) time.Duration {
+	return e.initialAge + now.Sub(e.storedAt)
+}
+
+func (e httpCacheEntry) isFresh(now time.Time) bool {
+	return !e.directives.noCache && e.age(now) < e.freshness
+}
+
+func (e httpCacheEntry) hasValidator() bool {
+	return findHeader(e.headers, "ETag") != "" || findHeader(e.headers, "Last-Modified") != ""
+}
+
+func (e httpCacheEntry) canServeStaleOnError(now time.Time, requestDirectives cacheDirectives) bool {
+	if e.directives.mustRevalidate || e.directives.noCache {
+		return false
+	}
+
+	staleIfError := e.directives.staleIfError
+	if requestDirectives.staleIfError > staleIfError {
+		staleIfError = requestDirectives.staleIfError
+	}
+
+	staleness := e.age(now) - e.freshness
+	return staleIfError >= 0 && staleness <= time.Duration(staleIfError)*time.Second
+}
+
+// revalidate updates the entry with the headers of
+// a `304 Not Modified` response from the upstream.
+func (e httpCacheEntry) revalidate(response restql.HTTPResponse, now time.Time) httpCacheEntry {
+	headers := copyHeaders(e.headers)
+	for k, v := range response.Headers {
+		removeHeader(headers, k)
+		headers[k] = v
+	}
+	e.headers = headers
+
+	return e.withFreshness(now)
+}
+
+func (e httpCacheEntry) response(log restql.Logger, start time.Time) restql.HTTPResponse {
+	headers := copyHeaders(e.headers)
+	removeHeader(headers, "Age")
+	headers["Age"] = strconv.Itoa(int(e.age(time.Now()).Seconds()))
+
+	return restql.HTTPResponse{
+		URL:        e.url,
+		StatusCode: e.statusCode,
+		Headers:    headers,
+		Body:       restql.NewResponseBodyFromBytes(log, e.body),
+		Duration:   time.Since(start),
+	}
+}
+
+func withValidators(request restql.HTTPRequest, entry httpCacheEntry) restql.HTTPRequest {
+	headers := make(restql.Headers, len(request.Headers)+2)
+	for k, v := range request.Headers {
+		headers[k] = v
+	}
+
+	if etag := findHeader(entry.headers, "ETag"); etag != "" {
+		removeHeader(headers, "If-None-Match")
+		headers["If-None-Match"] = etag
+	}
+
+	if lastModified := findHeader(entry.headers, "Last-Modified"); lastModified != "" {
+		removeHeader(headers, "If-Modified-Since")
+		headers["If-Modified-Since"] = lastModified
+	}
+
+	request.Headers = headers
+	return request
+}
+
+// cacheDirectives represents the `Cache-Control` header
+// directives relevant to a shared cache. Durations are in
+// seconds, being negative when the directive is absent.
+type cacheDirectives struct {
+	noStore        bool
+	noCache        bool
+	private        bool
+	public         bool
+	mustRevalidate bool
+	maxAge         int
+	sMaxAge        int
+	staleIfError   int
+}
+
+func parseCacheDirectives(cacheControl string) cacheDirectives {
+	d := cacheDirectives{maxAge: -1, sMaxAge: -1, staleIfError: -1}
+
+	for _, field := range strings.Split(cacheControl, ",") {
+		name, value := field, ""
+		if i := strings.Index(field, "="); i >= 0 {
+			name, value = field[:i], strings.Trim(strings.TrimSpace(field[i+1:]), `"`)
+		}
+
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "no-store":
+			d.noStore = true
+		case "no-cache":
+			d.noCache = true
+		case "private":
+			d.private = true
+		case "public":
+			d.public = true
+		case "must-revalidate", "proxy-revalidate":
+			d.mustRevalidate = true
+		case "max-age":
+			d.maxAge = parseDirectiveSeconds(value)
+		case "s-maxage":
+			d.sMaxAge = parseDirectiveSeconds(value)
+		case "stale-if-error":
+			d.staleIfError = parseDirectiveSeconds(value)
+		}
+	}
+
+	return d
+}
+
+func parseDirectiveSeconds(value string) int {
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return -1
+	}
+
+	return seconds
+}
+
+func requestTarget(request restql.HTTPRequest) string {
+	return request.Schema + "://" + request.Host + request.Path
+}
+
+func findHeader(headers restql.Headers, name string) string {
+	for k, v := range headers {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+
+	return ""
+}
+
+func removeHeader(headers restql.Headers, name string) {
+	for k := range headers {
+		if strings.EqualFold(k, name) {
+			delete(headers, k)
+		}
+	}
+}
+
+func copyHeaders(headers restql.Headers) restql.Headers {
+	c := make(restql.Headers, len(headers))
+	for k, v := range headers {
+		c[k] = v
+	}
+
+	return c
+}
@@ -0,0 +1,269 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/internal/platform/cache"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+	"github.com/b2wdigital/restQL-golang/v6/test"
+)
+
+type stubHTTPClient struct {
+	requests  []restql.HTTPRequest
+	responses []stubResponse
+}
+
+type stubResponse struct {
+	response restql.HTTPResponse
+	err      error
+}
+
+func (s *stubHTTPClient) Do(_ context.Context, request restql.HTTPRequest) (restql.HTTPResponse, error) {
+	s.requests = append(s.requests, request)
+
+	r := s.responses[0]
+	if len(s.responses) > 1 {
+		s.responses = s.responses[1:]
+	}
+
+	return r.response, r.err
+}
+
+func response(status int, headers restql.Headers, body string) stubResponse {
+	return stubResponse{response: restql.HTTPResponse{
+		URL:        "http://hero.api/hero?id=1",
+		StatusCode: status,
+		Headers:    headers,
+		Body:       restql.NewResponseBodyFromBytes(test.NoOpLogger, []byte(body)),
+	}}
+}
+
+func heroRequest() restql.HTTPRequest {
+	return restql.HTTPRequest{
+		Method:  http.MethodGet,
+		Schema:  "http",
+		Host:    "hero.api",
+		Path:    "/hero",
+		Query:   map[string]interface{}{"id": "1"},
+		Headers: restql.Headers{"X-Tid": "abc"},
+	}
+}
+
+func newHTTPClientCache(t *testing.T, client *stubHTTPClient, options cache.HTTPClientCacheOptions) *cache.HTTPClientCache {
+	t.Helper()
+	c, err := cache.NewHTTPClientCache(test.NoOpLogger, client, options)
+	test.VerifyError(t, err)
+	return c
+}
+
+func bodyOf(t *testing.T, r restql.HTTPResponse) string {
+	t.Helper()
+	return string(r.Body.Bytes())
+}
+
+func TestHTTPClientCache(t *testing.T) {
+	ctx := domain.WithTenant(context.Background(), "acme")
+	options := cache.HTTPClientCacheOptions{MaxSize: 10}
+
+	t.Run("should fail when max size is not greater than zero", func(t *testing.T) {
+		for _, size := range []int{0, -1} {
+			_, err := cache.NewHTTPClientCache(test.NoOpLogger, &stubHTTPClient{}, cache.HTTPClientCacheOptions{MaxSize: size})
+
+			test.Equal(t, err.Error(), fmt.Sprintf("invalid http cache max size %d: it must be greater than zero", size))
+		}
+	})
+
+	t.Run("should serve fresh response from cache", func(t *testing.T) {
+		client := &stubHTTPClient{responses: []stubResponse{
+			response(200, restql.Headers{"cache-control": "max-age=60"}, `{"id": 1}`),
+		}}
+		c := newHTTPClientCache(t, client, options)
+
+		_, err := c.Do(ctx, heroRequest())
+		test.VerifyError(t, err)
+
+		got, err := c.Do(ctx, heroRequest())
+		test.VerifyError(t, err)
+
+		test.Equal(t, len(client.requests), 1)
+		test.Equal(t, got.StatusCode, 200)
+		test.Equal(t, bodyOf(t, got), `{"id": 1}`)
+		test.Equal(t, got.Headers["Age"], "0")
+	})
+
+	t.Run("should not share entries between tenants, queries and key headers", func(t *testing.T) {
+		client := &stubHTTPClient{responses: []stubResponse{
+			response(200, restql.Headers{"Cache-Control": "max-age=60"}, `{}`),
+		}}
+		c := newHTTPClientCache(t, client, cache.HTTPClientCacheOptions{MaxSize: 10, KeyHeaders: []string{"x-tid"}})
+
+		otherQuery := heroRequest()
+		otherQuery.Query = map[string]interface{}{"id": "2"}
+
+		otherHeader := heroRequest()
+		otherHeader.Headers = restql.Headers{"X-Tid": "def"}
+
+		_, _ = c.Do(ctx, heroRequest())
+		_, _ = c.Do(domain.WithTenant(context.Background(), "other"), heroRequest())
+		_, _ = c.Do(ctx, otherQuery)
+		_, _ = c.Do(ctx, otherHeader)
+		_, _ = c.Do(ctx, heroRequest())
+
+		test.Equal(t, len(client.requests), 4)
+	})
+
+	t.Run("should not store responses that forbid it", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			response stubResponse
+			request  restql.HTTPRequest
+		}{
+			{"no-store", response(200, restql.Headers{"Cache-Control": "no-store, max-age=60"}, `{}`), heroRequest()},
+			{"private", response(200, restql.Headers{"Cache-Control": "private, max-age=60"}, `{}`), heroRequest()},
+			{"without freshness nor validator", response(200, restql.Headers{}, `{}`), heroRequest()},
+			{"not cacheable status", response(500, restql.Headers{"Cache-Control": "max-age=60"}, `{}`), heroRequest()},
+			{"vary on every header", response(200, restql.Headers{"Cache-Control": "max-age=60", "Vary": "*"}, `{}`), heroRequest()},
+			{"body larger than limit", response(200, restql.Headers{"Cache-Control": "max-age=60"}, `{"name": "a very long name"}`), heroRequest()},
+			{
+				"authorized request without public",
+				response(200, restql.Headers{"Cache-Control": "max-age=60"}, `{}`),
+				restql.HTTPRequest{Method: http.MethodGet, Schema: "http", Host: "hero.api", Path: "/hero", Headers: restql.Headers{"Authorization": "Bearer x"}},
+			},
+			{
+				"request with no-store",
+				response(200, restql.Headers{"Cache-Control": "max-age=60"}, `{}`),
+				restql.HTTPRequest{Method: http.MethodGet, Schema: "http", Host: "hero.api", Path: "/hero", Headers: restql.Headers{"Cache-Control": "no-store"}},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				client := &stubHTTPClient{responses: []stubResponse{tt.response}}
+				c := newHTTPClientCache(t, client, cache.HTTPClientCacheOptions{MaxSize: 10, MaxEntrySize: 10})
+
+				_, _ = c.Do(ctx, tt.request)
+				_, _ = c.Do(ctx, tt.request)
+
+				test.Equal(t, len(client.requests), 2)
+			})
+		}
+	})
+
+	t.Run("should use expires header when max-age is absent", func(t *testing.T) {
+		now := time.Now()
+		client := &stubHTTPClient{responses: []stubResponse{
+			response(200, restql.Headers{"Date": now.UTC().Format(http.TimeFormat), "Expires": now.Add(time.Minute).UTC().Format(http.TimeFormat)}, `{}`),
+		}}
+		c := newHTTPClientCache(t, client, options)
+
+		_, _ = c.Do(ctx, heroRequest())
+		_, _ = c.Do(ctx, heroRequest())
+
+		test.Equal(t, len(client.requests), 1)
+	})
+
+	t.Run("should revalidate stale response with etag", func(t *testing.T) {
+		client := &stubHTTPClient{responses: []stubResponse{
+			response(200, restql.Headers{"Cache-Control": "max-age=0", "ETag": `"v1"`}, `{"id": 1}`),
+			response(304, restql.Headers{"Cache-Control": "max-age=60"}, ``),
+		}}
+		c := newHTTPClientCache(t, client, options)
+
+		_, _ = c.Do(ctx, heroRequest())
+		revalidated, err := c.Do(ctx, heroRequest())
+		test.VerifyError(t, err)
+		cached, err := c.Do(ctx, heroRequest())
+		test.VerifyError(t, err)
+
+		test.Equal(t, len(client.requests), 2)
+		test.Equal(t, client.requests[1].Headers, restql.Headers{"X-Tid": "abc", "If-None-Match": `"v1"`})
+		test.Equal(t, heroRequest().Headers, restql.Headers{"X-Tid": "abc"})
+		test.Equal(t, revalidated.StatusCode, 200)
+		test.Equal(t, bodyOf(t, revalidated), `{"id": 1}`)
+		test.Equal(t, bodyOf(t, cached), `{"id": 1}`)
+	})
+
+	t.Run("should serve stale response on upstream failure when allowed", func(t *testing.T) {
+		client := &stubHTTPClient{responses: []stubResponse{
+			response(200, restql.Headers{"Cache-Control": "max-age=0, stale-if-error=60"}, `{"id": 1}`),
+			{response: restql.HTTPResponse{StatusCode: 408}, err: domain.ErrRequestTimeout},
+			response(503, restql.Headers{}, `{}`),
+		}}
+		c := newHTTPClientCache(t, client, options)
+
+		_, _ = c.Do(ctx, heroRequest())
+
+		timedOut, err := c.Do(ctx, heroRequest())
+		test.VerifyError(t, err)
+		test.Equal(t, bodyOf(t, timedOut), `{"id": 1}`)
+
+		unavailable, err := c.Do(ctx, heroRequest())
+		test.VerifyError(t, err)
+		test.Equal(t, unavailable.StatusCode, 200)
+		test.Equal(t, bodyOf(t, unavailable), `{"id": 1}`)
+	})
+
+	t.Run("should return upstream failure when stale response is not allowed", func(t *testing.T) {
+		upstreamErr := errors.New("connection refused")
+		client := &stubHTTPClient{responses: []stubResponse{
+			response(200, restql.Headers{"Cache-Control": "max-age=0, stale-if-error=60, must-revalidate"}, `{"id": 1}`),
+			{response: restql.HTTPResponse{StatusCode: 0}, err: upstreamErr},
+		}}
+		c := newHTTPClientCache(t, client, options)
+
+		_, _ = c.Do(ctx, heroRequest())
+		_, err := c.Do(ctx, heroRequest())
+
+		test.Equal(t, errors.Is(err, upstreamErr), true)
+	})
+
+	t.Run("should invalidate entries after unsafe request", func(t *testing.T) {
+		client := &stubHTTPClient{responses: []stubResponse{
+			response(200, restql.Headers{"Cache-Control": "max-age=60"}, `{}`),
+			response(201, restql.Headers{}, `{}`),
+			response(200, restql.Headers{"Cache-Control": "max-age=60"}, `{}`),
+		}}
+		c := newHTTPClientCache(t, client, options)
+
+		post := heroRequest()
+		post.Method = http.MethodPost
+		post.Query = nil
+
+		_, _ = c.Do(ctx, heroRequest())
+		_, _ = c.Do(ctx, post)
+		_, _ = c.Do(ctx, heroRequest())
+
+		test.Equal(t, len(client.requests), 3)
+	})
+	t.Run("should invalidate only entries of the unsafe request target", func(t *testing.T) {
+		client := &stubHTTPClient{responses: []stubResponse{
+			response(200, restql.Headers{"Cache-Control": "max-age=60"}, `{}`),
+		}}
+		c := newHTTPClientCache(t, client, cache.HTTPClientCacheOptions{MaxSize: 2})
+
+		otherHero := heroRequest()
+		otherHero.Query = map[string]interface{}{"id": "2"}
+
+		villain := heroRequest()
+		villain.Path = "/villain"
+
+		post := heroRequest()
+		post.Method = http.MethodPost
+		post.Query = nil
+
+		_, _ = c.Do(ctx, heroRequest())
+		_, _ = c.Do(ctx, otherHero)
+		_, _ = c.Do(ctx, villain)
+		_, _ = c.Do(ctx, post)
+		_, _ = c.Do(ctx, villain)
+		_, _ = c.Do(ctx, heroRequest())
+
+		test.Equal(t, len(client.requests), 5)
+	})
+}
@@ -11,13 +11,14 @@ import (
 
 // ParserCache is a caching wrapper that implements the Parser interface.
 type ParserCache struct {
-	log   restql.Logger
-	cache *Cache
+	log    restql.Logger
+	cache  *Cache
+	parser parser.Parser
 }
 
 // NewParserCache constructs a ParserCache instance.
-func NewParserCache(log restql.Logger, c *Cache) ParserCache {
-	return ParserCache{log: log, cache: c}
+func NewParserCache(log restql.Logger, c *Cache, p parser.Parser) ParserCache {
+	return ParserCache{log: log, cache: c, parser: p}
 }
 
 // Parse returns a cached QueryRevisions internal representation if
@@ -36,6 +37,12 @@ func (p ParserCache) Parse(queryStr string) (domain.Query, error) {
 	return query, nil
 }
 
+// Format rewrites the query in the canonical layout
+// using the wrapped parser, without caching the result.
+func (p ParserCache) Format(queryStr string) (string, error) {
+	return p.parser.Format(queryStr)
+}
+
 // ParserCacheLoader is the strategy to load
 // values for the cached parser.
 func ParserCacheLoader(p parser.Parser) Loader {
@@ -73,6 +73,7 @@ type Config struct {
 		Client struct {
 			MaxConcurrentQueries    int `yaml:"maxConcurrentQueries" env:"RESTQL_MAX_CONCURRENT_QUERIES"`
 			MaxConcurrentGoroutines int `yaml:"maxConcurrentGoroutines" env:"RESTQL_MAX_CONCURRENT_GOROUTINES"`
+			MaxFanOut               int `yaml:"maxFanOut" env:"RESTQL_MAX_FAN_OUT"`
 
 			DnsRefreshInterval  time.Duration `yaml:"dnsRefreshInterval"`
 			ConnTimeout         time.Duration `yaml:"connectionTimeout"`
@@ -81,6 +82,12 @@ type Config struct {
 			MaxIdleConns        int           `yaml:"maxIdleConnections"`
 			MaxIdleConnsPerHost int           `yaml:"maxIdleConnectionsPerHost"`
 			MaxIdleConnDuration time.Duration `yaml:"maxIdleConnectionDuration"`
+
+			RetryStatusCodes []int `yaml:"retryStatusCodes"`
+
+			Coalescing struct {
+				Enable bool `yaml:"enable" env:"RESTQL_REQUEST_COALESCING_ENABLE"`
+			} `yaml:"coalescing"`
 		} `yaml:"client"`
 	} `yaml:"http"`
 
@@ -105,6 +112,12 @@ type Config struct {
 		Parser struct {
 			MaxSize int `yaml:"maxSize" env:"RESTQL_CACHE_PARSER_MAX_SIZE"`
 		} `yaml:"parser"`
+		HTTP struct {
+			Enable       bool     `yaml:"enable" env:"RESTQL_CACHE_HTTP_ENABLE"`
+			MaxSize      int      `yaml:"maxSize" env:"RESTQL_CACHE_HTTP_MAX_SIZE"`
+			MaxEntrySize int      `yaml:"maxEntrySize" env:"RESTQL_CACHE_HTTP_MAX_ENTRY_SIZE"`
+			KeyHeaders   []string `yaml:"keyHeaders"`
+		} `yaml:"http"`
 	} `yaml:"cache"`
 
 	Plugins struct {
@@ -22,6 +22,10 @@ http:
     writeTimeout: 1s
     maxIdleConnectionsPerHost: 512
     maxIdleConnectionDuration: 10s
+    retryStatusCodes: [502, 503, 504]
+    maxFanOut: 1000
+    coalescing:
+      enable: false
 
 logging:
   enable: true
@@ -36,6 +40,10 @@ cache:
     maxSize: 100
   parser:
     maxSize: 100
+  http:
+    enable: false
+    maxSize: 1000
+    maxEntrySize: 1048576
 
 database:
   timeout: 1000
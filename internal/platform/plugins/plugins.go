@@ -40,6 +40,16 @@ func NewLifecycle(log restql.Logger) (Lifecycle, error) {
 	return manager{log: log, availablePlugins: ps}, nil
 }
 
+// NewFunctions loads the registered function plugins.
+func NewFunctions(log restql.Logger) []restql.FunctionPlugin {
+	fs := loadFunctionPlugins(log)
+	if len(fs) == 0 {
+		log.Info("no function plugin provided")
+	}
+
+	return fs
+}
+
 func (m manager) BeforeTransaction(ctx context.Context, requestCtx *fasthttp.RequestCtx) context.Context {
 	return m.executeAllPluginsWithContext(ctx, "BeforeTransaction", func(currentCtx context.Context, p restql.LifecyclePlugin) context.Context {
 		log := restql.GetLogger(ctx)
@@ -25,3 +25,24 @@ func loadLifecyclePlugins(logger restql.Logger) []restql.LifecyclePlugin {
 	}
 	return ps
 }
+
+func loadFunctionPlugins(logger restql.Logger) []restql.FunctionPlugin {
+	var ps []restql.FunctionPlugin
+	for _, pluginInfo := range restql.GetFunctionPlugins() {
+		p, err := pluginInfo.New(logger)
+		if err != nil {
+			logger.Error("failed to load plugin", err)
+			continue
+		}
+
+		pluginInstance, ok := p.(restql.FunctionPlugin)
+		if !ok {
+			logger.Error("failed to load plugin", errors.Errorf("plugin of incorrect type: %T", p))
+			continue
+		}
+
+		logger.Debug("plugin loaded", "name", pluginInstance.Name())
+		ps = append(ps, pluginInstance)
+	}
+	return ps
+}
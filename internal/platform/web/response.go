@@ -7,6 +7,7 @@ import (
 	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
 	"github.com/b2wdigital/restQL-golang/v6/internal/eval"
 	"github.com/b2wdigital/restQL-golang/v6/internal/parser"
+	"github.com/b2wdigital/restQL-golang/v6/internal/parser/ast"
 	"github.com/b2wdigital/restQL-golang/v6/internal/platform/persistence"
 	"github.com/b2wdigital/restQL-golang/v6/internal/runner"
 	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
@@ -38,8 +39,24 @@ var errToStatusCode = map[error]int{
 }
 
 // ErrorResponse is the form used for API responses from failures in the API.
+// Syntax errors on the query also inform where it happened and how to fix it,
+// while invalid params list every violation found.
 type ErrorResponse struct {
-	Error string `json:"error"`
+	Error      string   `json:"error"`
+	Line       int      `json:"line,omitempty"`
+	Column     int      `json:"column,omitempty"`
+	Token      string   `json:"token,omitempty"`
+	Expected   []string `json:"expected,omitempty"`
+	Suggestion string   `json:"suggestion,omitempty"`
+
+	Violations []ParamViolation `json:"violations,omitempty"`
+}
+
+// ParamViolation represents the client format of an input
+// that does not comply with the query declared params.
+type ParamViolation struct {
+	Param   string `json:"param"`
+	Message string `json:"message"`
 }
 
 // Respond write the information back to the client.
@@ -64,13 +81,38 @@ func Respond(ctx *fasthttp.RequestCtx, data interface{}, statusCode int, headers
 func RespondError(ctx *fasthttp.RequestCtx, err error, toStatusCode map[error]int) error {
 	status := findStatusCode(toStatusCode, err)
 
-	er := ErrorResponse{Error: err.Error()}
+	er := NewErrorResponse(err)
 	if err := Respond(ctx, er, status, nil); err != nil {
 		return err
 	}
 	return nil
 }
 
+// NewErrorResponse builds the ErrorResponse for the given error,
+// detailing the parse error if it is one.
+func NewErrorResponse(err error) ErrorResponse {
+	er := ErrorResponse{Error: err.Error()}
+
+	var pe ast.ParseError
+	if errors.As(err, &pe) {
+		er.Line = pe.Line
+		er.Column = pe.Column
+		er.Token = pe.Token
+		er.Expected = pe.Expected
+		er.Suggestion = pe.Suggestion
+	}
+
+	var paramsErr eval.ParamsError
+	if errors.As(err, &paramsErr) {
+		er.Violations = make([]ParamViolation, len(paramsErr.Violations))
+		for i, v := range paramsErr.Violations {
+			er.Violations[i] = ParamViolation{Param: v.Param, Message: v.Message}
+		}
+	}
+
+	return er
+}
+
 func findStatusCode(toStatusCode map[error]int, err error) int {
 	for e, status := range toStatusCode {
 		if errors.Is(err, e) {
@@ -89,6 +131,9 @@ type StatementDebugging struct {
 	Params          map[string]interface{} `json:"params,omitempty"`
 	RequestBody     interface{}            `json:"request-body,omitempty"`
 	ResponseTime    int64                  `json:"response-time,omitempty"`
+	Retries         int                    `json:"retries,omitempty"`
+	Coalesced       bool                   `json:"coalesced,omitempty"`
+	Pages           []StatementDebugging   `json:"pages,omitempty"`
 }
 
 // StatementMetadata represents the client format of metadata
@@ -98,10 +143,13 @@ type StatementMetadata struct {
 
 // StatementDetails represents the client format of the statement details
 type StatementDetails struct {
-	Status   int                 `json:"status"`
-	Success  bool                `json:"success"`
-	Metadata StatementMetadata   `json:"metadata"`
-	Debug    *StatementDebugging `json:"debug,omitempty"`
+	Status     int                 `json:"status"`
+	Success    bool                `json:"success"`
+	Skipped    bool                `json:"skipped,omitempty"`
+	SkipReason string              `json:"skip-reason,omitempty"`
+	Fallback   bool                `json:"fallback,omitempty"`
+	Metadata   StatementMetadata   `json:"metadata"`
+	Debug      *StatementDebugging `json:"debug,omitempty"`
 }
 
 // StatementResult represents the client format of the statement result
@@ -184,9 +232,12 @@ func parseDetails(resource restql.DoneResource, debug bool) StatementDetails {
 	}
 
 	sd := StatementDetails{
-		Status:   resource.Status,
-		Success:  resource.Success,
-		Metadata: metadata,
+		Status:     resource.Status,
+		Success:    resource.Success,
+		Skipped:    resource.Skipped,
+		SkipReason: resource.SkipReason,
+		Fallback:   resource.Fallback,
+		Metadata:   metadata,
 	}
 
 	if debug {
@@ -197,7 +248,7 @@ func parseDetails(resource restql.DoneResource, debug bool) StatementDetails {
 }
 
 func parseDebug(resource restql.DoneResource) *StatementDebugging {
-	return &StatementDebugging{
+	sd := &StatementDebugging{
 		Method:          resource.Method,
 		URL:             resource.URL,
 		RequestHeaders:  resource.RequestHeaders,
@@ -205,7 +256,18 @@ func parseDebug(resource restql.DoneResource) *StatementDebugging {
 		Params:          resource.RequestParams,
 		RequestBody:     resource.RequestBody,
 		ResponseTime:    resource.ResponseTime,
+		Retries:         resource.Retries,
+		Coalesced:       resource.Coalesced,
 	}
+
+	if len(resource.Pages) > 0 {
+		sd.Pages = make([]StatementDebugging, len(resource.Pages))
+		for i, page := range resource.Pages {
+			sd.Pages[i] = *parseDebug(page)
+		}
+	}
+
+	return sd
 }
 
 // CalculateStatusCode returns the greater status in all
@@ -233,7 +295,7 @@ var statusNormalization = map[int]int{0: 500, 204: 200, 201: 200}
 func calculateResultStatusCode(result interface{}) int {
 	switch r := result.(type) {
 	case restql.DoneResource:
-		if r.IgnoreErrors {
+		if r.IgnoreErrors || r.Fallback {
 			return 200
 		}
 
@@ -407,3 +469,115 @@ func appendMap(m1 map[string]string, m2 map[string]string) map[string]string {
 
 	return m
 }
+
+// LintIssue represents the client format of a problem found on the query
+type LintIssue struct {
+	Severity  string `json:"severity"`
+	Rule      string `json:"rule"`
+	Statement string `json:"statement,omitempty"`
+	Message   string `json:"message"`
+}
+
+// LintResponse represents the client format of the query lint result
+type LintResponse struct {
+	Issues []LintIssue `json:"issues"`
+}
+
+// MakeLintResponse create a query lint response for the client.
+func MakeLintResponse(issues []eval.LintIssue) LintResponse {
+	response := LintResponse{Issues: make([]LintIssue, len(issues))}
+	for i, issue := range issues {
+		response.Issues[i] = LintIssue{
+			Severity:  issue.Severity,
+			Rule:      issue.Rule,
+			Statement: issue.Statement,
+			Message:   issue.Message,
+		}
+	}
+
+	return response
+}
+
+// ExplainedStatement represents the client format of a statement execution plan
+type ExplainedStatement struct {
+	Wave      int      `json:"wave"`
+	Method    string   `json:"method"`
+	Resource  string   `json:"resource"`
+	DependsOn []string `json:"depends-on"`
+	FanOut    int      `json:"fan-out"`
+	Dynamic   bool     `json:"dynamic-fan-out"`
+	URLs      []string `json:"urls"`
+}
+
+// ExplainResponse represents the client format of the query execution plan
+type ExplainResponse struct {
+	Waves      [][]string                    `json:"waves"`
+	Statements map[string]ExplainedStatement `json:"statements"`
+}
+
+// MakeExplainResponse create a query execution plan response for the client.
+func MakeExplainResponse(plan runner.Plan) ExplainResponse {
+	response := ExplainResponse{
+		Waves:      plan.Waves,
+		Statements: make(map[string]ExplainedStatement, len(plan.Statements)),
+	}
+
+	for id, stmt := range plan.Statements {
+		response.Statements[id] = ExplainedStatement{
+			Wave:      stmt.Wave,
+			Method:    stmt.Method,
+			Resource:  stmt.Resource,
+			DependsOn: stmt.DependsOn,
+			FanOut:    stmt.FanOut,
+			Dynamic:   stmt.Dynamic,
+			URLs:      stmt.URLs,
+		}
+	}
+
+	return response
+}
+
+// DryRunRequest represents the client format of a request built in dry-run mode
+type DryRunRequest struct {
+	Method  string                 `json:"method"`
+	URL     string                 `json:"url"`
+	Query   map[string]interface{} `json:"query,omitempty"`
+	Headers map[string]string      `json:"headers,omitempty"`
+	Body    interface{}            `json:"body,omitempty"`
+	Timeout int64                  `json:"timeout,omitempty"`
+}
+
+// DryRunStatement represents the client format of a statement in dry-run mode
+type DryRunStatement struct {
+	Requests     []DryRunRequest `json:"requests"`
+	Unresolvable bool            `json:"unresolvable,omitempty"`
+	Chained      []string        `json:"chained,omitempty"`
+	Skipped      bool            `json:"skipped,omitempty"`
+}
+
+// MakeDryRunResponse create a dry-run response for the client.
+func MakeDryRunResponse(statements map[string]runner.RenderedStatement) map[string]DryRunStatement {
+	response := make(map[string]DryRunStatement, len(statements))
+	for id, stmt := range statements {
+		requests := make([]DryRunRequest, len(stmt.Requests))
+		for i, r := range stmt.Requests {
+			requests[i] = DryRunRequest{
+				Method:  r.Method,
+				URL:     r.URL,
+				Query:   r.Query,
+				Headers: r.Headers,
+				Body:    r.Body,
+				Timeout: r.Timeout.Milliseconds(),
+			}
+		}
+
+		response[id] = DryRunStatement{
+			Requests:     requests,
+			Unresolvable: stmt.Unresolvable,
+			Chained:      stmt.Chained,
+			Skipped:      stmt.Skipped,
+		}
+	}
+
+	return response
+}
@@ -2,7 +2,11 @@ package web_test
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/internal/eval"
+	"github.com/b2wdigital/restQL-golang/v6/internal/parser/ast"
 	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
 	"testing"
 
@@ -38,6 +42,28 @@ func TestMakeQueryResponse(t *testing.T) {
 				Headers: map[string]string{},
 			},
 		},
+		{
+			"should make response with skip reason for skipped result",
+			domain.Resources{
+				"loyalty": restql.DoneResource{
+					Status:       204,
+					Success:      true,
+					Skipped:      true,
+					SkipReason:   "when condition not met",
+					ResponseBody: restql.NewResponseBodyFromBytes(test.NoOpLogger, nil),
+				},
+			},
+			false,
+			web.QueryResponse{
+				StatusCode: 200,
+				Body: map[string]web.StatementResult{
+					"loyalty": {
+						Details: web.StatementDetails{Status: 204, Success: true, Skipped: true, SkipReason: "when condition not met"},
+					},
+				},
+				Headers: map[string]string{},
+			},
+		},
 		{
 			"should make response with metadata",
 			domain.Resources{
@@ -421,6 +447,40 @@ func TestMakeQueryResponse(t *testing.T) {
 				},
 			},
 		},
+		{
+			"should make response with debugging of paginated statement",
+			domain.Resources{
+				"product": restql.DoneResource{
+					Status:       200,
+					Success:      true,
+					URL:          "http://product.io/api",
+					ResponseTime: 30,
+					ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{"items": [1, 2]}`)),
+					Pages: []restql.DoneResource{
+						{Status: 200, Success: true, URL: "http://product.io/api", ResponseTime: 10},
+						{Status: 200, Success: true, URL: "http://product.io/api?cursor=b", ResponseTime: 20},
+					},
+				},
+			},
+			true,
+			web.QueryResponse{
+				StatusCode: 200,
+				Body: map[string]web.StatementResult{
+					"product": {
+						Details: web.StatementDetails{Status: 200, Success: true, Debug: &web.StatementDebugging{
+							URL:          "http://product.io/api",
+							ResponseTime: 30,
+							Pages: []web.StatementDebugging{
+								{URL: "http://product.io/api", ResponseTime: 10},
+								{URL: "http://product.io/api?cursor=b", ResponseTime: 20},
+							},
+						}},
+						Result: rawResult(`{"items": [1, 2]}`),
+					},
+				},
+				Headers: map[string]string{},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -480,6 +540,14 @@ func TestCalculateStatusCode(t *testing.T) {
 			},
 			200,
 		},
+		{
+			"should return max status code expect for result replaced by fallback",
+			domain.Resources{
+				"hero":     restql.DoneResource{Status: 200},
+				"sidekick": restql.DoneResource{Status: 503, Fallback: true},
+			},
+			200,
+		},
 	}
 
 	for _, tt := range tests {
@@ -499,3 +567,51 @@ func rawResult(s string) json.RawMessage {
 
 	return json.RawMessage(b)
 }
+
+func TestNewErrorResponse(t *testing.T) {
+	t.Run("should detail parse errors", func(t *testing.T) {
+		pe := ast.ParseError{
+			Line:       1,
+			Column:     11,
+			Token:      "wiht",
+			Expected:   []string{"only", "with"},
+			Suggestion: `did you mean "with"?`,
+			Message:    `unexpected "wiht"`,
+		}
+		err := fmt.Errorf("wrapped: %w", pe)
+
+		expected := web.ErrorResponse{
+			Error:      `wrapped: line 1, column 11: unexpected "wiht": did you mean "with"?`,
+			Line:       1,
+			Column:     11,
+			Token:      "wiht",
+			Expected:   []string{"only", "with"},
+			Suggestion: `did you mean "with"?`,
+		}
+
+		test.Equal(t, web.NewErrorResponse(err), expected)
+	})
+
+	t.Run("should list param violations", func(t *testing.T) {
+		err := eval.ParamsError{Violations: []eval.ParamViolation{
+			{Param: "id", Message: "is required"},
+			{Param: "page", Message: "must be an int"},
+		}}
+
+		expected := web.ErrorResponse{
+			Error: "validation error: invalid params: id is required; page must be an int",
+			Violations: []web.ParamViolation{
+				{Param: "id", Message: "is required"},
+				{Param: "page", Message: "must be an int"},
+			},
+		}
+
+		test.Equal(t, web.NewErrorResponse(err), expected)
+	})
+
+	t.Run("should only have message for other errors", func(t *testing.T) {
+		err := errors.New("unknown mappings")
+
+		test.Equal(t, web.NewErrorResponse(err), web.ErrorResponse{Error: "unknown mappings"})
+	})
+}
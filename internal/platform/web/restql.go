@@ -39,14 +39,36 @@ func (r restQl) ValidateQuery(ctx *fasthttp.RequestCtx) error {
 	_, err := r.parser.Parse(queryTxt)
 	if err != nil {
 		r.log.Error("an error occurred when parsing query", err)
-		e := fmt.Errorf("%w: %s", parser.ErrInvalidQuery, err)
-
-		return RespondError(ctx, e, errToStatusCode)
+		return respondInvalidQuery(ctx, err)
 	}
 
 	return Respond(ctx, nil, http.StatusOK, nil)
 }
 
+func (r restQl) FormatQuery(ctx *fasthttp.RequestCtx) error {
+	queryTxt := string(ctx.PostBody())
+	formatted, err := r.parser.Format(queryTxt)
+	if err != nil {
+		r.log.Debug("an error occurred when formatting query", "error", err)
+		return respondInvalidQuery(ctx, err)
+	}
+
+	ctx.Response.Header.SetContentType("text/plain; charset=utf-8")
+	ctx.Response.SetStatusCode(http.StatusOK)
+	ctx.Response.SetBodyString(formatted)
+
+	return nil
+}
+
+func respondInvalidQuery(ctx *fasthttp.RequestCtx, err error) error {
+	e := fmt.Errorf("%w: %s", parser.ErrInvalidQuery, err)
+
+	er := NewErrorResponse(err)
+	er.Error = e.Error()
+
+	return Respond(ctx, er, findStatusCode(errToStatusCode, e), nil)
+}
+
 func (r restQl) RunAdHocQuery(reqCtx *fasthttp.RequestCtx) error {
 	ctx := restql.WithLogger(reqCtx, r.log)
 
@@ -65,17 +87,20 @@ func (r restQl) RunAdHocQuery(reqCtx *fasthttp.RequestCtx) error {
 
 	queryTxt := string(reqCtx.PostBody())
 
+	if isDryRunEnabled(input) {
+		statements, err := r.evaluator.AdHocDryRun(ctx, queryTxt, options, input)
+		if err != nil {
+			r.log.Debug("failed to dry-run adhoc query", "error", err)
+			return RespondError(reqCtx, err, adHocErrToStatusCode())
+		}
+
+		return Respond(reqCtx, MakeDryRunResponse(statements), http.StatusOK, nil)
+	}
+
 	result, err := r.evaluator.AdHocQuery(ctx, queryTxt, options, input)
 	if err != nil {
 		r.log.Error("failed to evaluated adhoc query", err)
-
-		adhocErrToStatusCode := make(map[error]int)
-		for err, status := range errToStatusCode {
-			adhocErrToStatusCode[err] = status
-		}
-		adhocErrToStatusCode[eval.ErrParser] = http.StatusBadRequest
-
-		return RespondError(reqCtx, err, adhocErrToStatusCode)
+		return RespondError(reqCtx, err, adHocErrToStatusCode())
 	}
 
 	debugEnabled := isDebugEnabled(input)
@@ -87,6 +112,63 @@ func (r restQl) RunAdHocQuery(reqCtx *fasthttp.RequestCtx) error {
 	return Respond(reqCtx, response.Body, response.StatusCode, response.Headers)
 }
 
+func (r restQl) LintQuery(reqCtx *fasthttp.RequestCtx) error {
+	ctx := restql.WithLogger(reqCtx, r.log)
+
+	tenant := r.config.Tenant
+	if tenant == "" {
+		tenant = string(reqCtx.QueryArgs().Peek("tenant"))
+	}
+	options := restql.QueryOptions{Tenant: tenant}
+
+	queryTxt := string(reqCtx.PostBody())
+
+	issues, err := r.evaluator.LintQuery(ctx, queryTxt, options)
+	if err != nil {
+		r.log.Debug("failed to lint query", "error", err)
+		return RespondError(reqCtx, err, adHocErrToStatusCode())
+	}
+
+	return Respond(reqCtx, MakeLintResponse(issues), http.StatusOK, nil)
+}
+
+func (r restQl) ExplainQuery(reqCtx *fasthttp.RequestCtx) error {
+	ctx := restql.WithLogger(reqCtx, r.log)
+
+	tenant, err := makeTenant(reqCtx, r.config.Tenant)
+	if err != nil {
+		r.log.Error("failed to build query options", err)
+		return RespondError(reqCtx, err, errToStatusCode)
+	}
+	options := restql.QueryOptions{Tenant: tenant}
+
+	input, err := makeQueryInput(reqCtx, r.log)
+	if err != nil {
+		r.log.Error("failed to build query input", err)
+		return RespondError(reqCtx, err, errToStatusCode)
+	}
+
+	queryTxt := string(reqCtx.PostBody())
+
+	plan, err := r.evaluator.ExplainQuery(ctx, queryTxt, options, input)
+	if err != nil {
+		r.log.Debug("failed to explain query", "error", err)
+		return RespondError(reqCtx, err, adHocErrToStatusCode())
+	}
+
+	return Respond(reqCtx, MakeExplainResponse(plan), http.StatusOK, nil)
+}
+
+func adHocErrToStatusCode() map[error]int {
+	adhocErrToStatusCode := make(map[error]int)
+	for err, status := range errToStatusCode {
+		adhocErrToStatusCode[err] = status
+	}
+	adhocErrToStatusCode[eval.ErrParser] = http.StatusBadRequest
+
+	return adhocErrToStatusCode
+}
+
 func (r restQl) RunSavedQuery(reqCtx *fasthttp.RequestCtx) error {
 	log := r.log.With("restql-endpoint", string(reqCtx.Request.URI().Path()))
 	log = log.With("request-id", string(reqCtx.Request.Header.Peek("X-TID")))
@@ -106,6 +188,16 @@ func (r restQl) RunSavedQuery(reqCtx *fasthttp.RequestCtx) error {
 		return RespondError(reqCtx, err, errToStatusCode)
 	}
 
+	if isDryRunEnabled(input) {
+		statements, err := r.evaluator.SavedDryRun(ctx, options, input)
+		if err != nil {
+			log.Debug("failed to dry-run saved query", "error", err)
+			return RespondError(reqCtx, err, errToStatusCode)
+		}
+
+		return Respond(reqCtx, MakeDryRunResponse(statements), http.StatusOK, nil)
+	}
+
 	result, err := r.evaluator.SavedQuery(ctx, options, input)
 	if err != nil {
 		log.Error("failed to evaluated saved query", err)
@@ -228,10 +320,21 @@ func makeQueryInput(ctx *fasthttp.RequestCtx, log restql.Logger) (restql.QueryIn
 	return input, nil
 }
 
-const debugParamName = "_debug"
+const (
+	debugParamName  = "_debug"
+	dryRunParamName = "_dry-run"
+)
 
 func isDebugEnabled(queryInput restql.QueryInput) bool {
-	param, found := queryInput.Params[debugParamName]
+	return isFlagEnabled(queryInput, debugParamName)
+}
+
+func isDryRunEnabled(queryInput restql.QueryInput) bool {
+	return isFlagEnabled(queryInput, dryRunParamName)
+}
+
+func isFlagEnabled(queryInput restql.QueryInput, name string) bool {
+	param, found := queryInput.Params[name]
 	if !found {
 		return false
 	}
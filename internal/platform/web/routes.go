@@ -19,13 +19,14 @@ import (
 // API constructs a handler for the restQL query related endpoints
 func API(log restql.Logger, cfg *conf.Config) (fasthttp.RequestHandler, error) {
 	log.Debug("starting api")
-	defaultParser, err := parser.New()
+	functions := plugins.NewFunctions(log)
+	defaultParser, err := parser.New(parser.WithFunctions(functions...))
 	if err != nil {
 		log.Error("failed to compile parser", err)
 		return nil, err
 	}
 	parserCacheLoader := cache.New(log, cfg.Cache.Parser.MaxSize, cache.ParserCacheLoader(defaultParser))
-	parserCache := cache.NewParserCache(log, parserCacheLoader)
+	parserCache := cache.NewParserCache(log, parserCacheLoader, defaultParser)
 
 	databaseDisabled := cfg.Plugins.DisableDatabase
 	db, err := persistence.NewDatabase(log, databaseDisabled)
@@ -40,11 +41,29 @@ func API(log restql.Logger, cfg *conf.Config) (fasthttp.RequestHandler, error) {
 	}
 
 	client := httpclient.New(log, lifecycle, cfg)
-	executor := runner.NewExecutor(log, client, cfg.HTTP.QueryResourceTimeout, cfg.HTTP.ForwardPrefix)
+	if cfg.Cache.HTTP.Enable {
+		log.Info("upstream response cache enabled")
+		client, err = cache.NewHTTPClientCache(log, client, cache.HTTPClientCacheOptions{
+			MaxSize:      cfg.Cache.HTTP.MaxSize,
+			MaxEntrySize: cfg.Cache.HTTP.MaxEntrySize,
+			KeyHeaders:   cfg.Cache.HTTP.KeyHeaders,
+		})
+		if err != nil {
+			log.Error("failed to initialize upstream response cache", err)
+			return nil, err
+		}
+	}
+	executor := runner.NewExecutor(log, client, runner.ExecutorOptions{
+		ResourceTimeout:  cfg.HTTP.QueryResourceTimeout,
+		ForwardPrefix:    cfg.HTTP.ForwardPrefix,
+		RetryStatusCodes: cfg.HTTP.Client.RetryStatusCodes,
+		Coalescing:       cfg.HTTP.Client.Coalescing.Enable,
+	})
 	r := runner.NewRunner(log, executor, runner.Options{
 		GlobalQueryTimeout:      cfg.HTTP.GlobalQueryTimeout,
 		MaxConcurrentQueries:    cfg.HTTP.Client.MaxConcurrentQueries,
 		MaxConcurrentGoroutines: cfg.HTTP.Client.MaxConcurrentGoroutines,
+		MaxFanOut:               cfg.HTTP.Client.MaxFanOut,
 	})
 
 	mappingReader := persistence.NewMappingReader(log, cfg.Env, cfg.TenantMappings, db)
@@ -67,6 +86,9 @@ func API(log restql.Logger, cfg *conf.Config) (fasthttp.RequestHandler, error) {
 	md := middleware.NewDecorator(log, cfg, lifecycle)
 	app := newApp(log, appOptions{MiddlewareDecorator: md})
 	app.Handle(http.MethodPost, "/validate-query", restQl.ValidateQuery)
+	app.Handle(http.MethodPost, "/format-query", restQl.FormatQuery)
+	app.Handle(http.MethodPost, "/lint-query", restQl.LintQuery)
+	app.Handle(http.MethodPost, "/explain-query", restQl.ExplainQuery)
 	app.Handle(http.MethodPost, "/run-query", restQl.RunAdHocQuery)
 	app.Handle(http.MethodGet, "/run-query/{namespace}/{queryId}/{revision}", restQl.RunSavedQuery)
 	app.Handle(http.MethodPost, "/run-query/{namespace}/{queryId}/{revision}", restQl.RunSavedQuery)
@@ -0,0 +1,68 @@
+package runner
+
+import (
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+)
+
+// MergeBatches builds a single result from the responses of
+// the statements created by the batch function, hence chained
+// values and aggregations see the same result as if a single
+// request was made. Lists are concatenated and objects are merged,
+// like the pages of a paginated statement.
+// When a batch fails its response is returned instead.
+func MergeBatches(log restql.Logger, batches []restql.DoneResource) restql.DoneResource {
+	if len(batches) == 1 {
+		return batches[0]
+	}
+
+	var body interface{}
+	var responseTime int64
+	for _, b := range batches {
+		if !b.Success {
+			return b
+		}
+
+		if b.ResponseBody != nil {
+			body = mergePageBody(body, b.ResponseBody.Unmarshal())
+		}
+
+		if b.ResponseTime > responseTime {
+			responseTime = b.ResponseTime
+		}
+	}
+
+	result := batches[0]
+	result.ResponseTime = responseTime
+	result.ResponseBody = restql.NewResponseBodyFromValue(log, body)
+
+	return result
+}
+
+func isBatched(statements []interface{}) bool {
+	if len(statements) == 0 {
+		return false
+	}
+
+	for _, s := range statements {
+		stmt, ok := s.(domain.Statement)
+		if !ok || !stmt.Batched {
+			return false
+		}
+	}
+
+	return true
+}
+
+func mergeBatchResponses(log restql.Logger, responses restql.DoneResources) interface{} {
+	batches := make([]restql.DoneResource, len(responses))
+	for i, r := range responses {
+		dr, ok := r.(restql.DoneResource)
+		if !ok {
+			return responses
+		}
+		batches[i] = dr
+	}
+
+	return MergeBatches(log, batches)
+}
@@ -0,0 +1,97 @@
+package runner_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/internal/runner"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+	"github.com/b2wdigital/restQL-golang/v6/test"
+)
+
+func TestBatchedStatement(t *testing.T) {
+	var mu sync.Mutex
+	var requested []interface{}
+	client := stubHTTPClient(func(request restql.HTTPRequest) (restql.HTTPResponse, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		ids := request.Query["id"]
+		requested = append(requested, ids)
+
+		var items []interface{}
+		for _, id := range ids.([]interface{}) {
+			items = append(items, map[string]interface{}{"id": id, "heroId": fmt.Sprintf("h%v", id)})
+		}
+
+		return restql.HTTPResponse{
+			StatusCode: 200,
+			Body:       restql.NewResponseBodyFromValue(test.NoOpLogger, items),
+		}, nil
+	})
+
+	executor := runner.NewExecutor(test.NoOpLogger, client, runner.ExecutorOptions{ResourceTimeout: time.Second})
+	r := runner.NewRunner(test.NoOpLogger, executor, runner.Options{GlobalQueryTimeout: time.Second})
+
+	queryCtx := restql.QueryContext{Mappings: map[string]restql.Mapping{
+		"sidekick": mapping(t, "http://sidekick.io/api"),
+		"hero":     mapping(t, "http://hero.io/api"),
+	}}
+
+	query := domain.Query{Statements: []domain.Statement{
+		{Method: "from", Resource: "sidekick", With: domain.Params{Values: map[string]interface{}{"id": domain.NewBatch([]interface{}{"1", "2", "3", "4", "5"}, 2)}}},
+		{Method: "from", Resource: "hero", With: domain.Params{Values: map[string]interface{}{"id": domain.NewBatch(domain.Chain{"sidekick", "id"}, 5)}}},
+	}}
+
+	got, err := r.ExecuteQuery(context.Background(), query, queryCtx)
+	test.VerifyError(t, err)
+
+	sidekick := got["sidekick"].(restql.DoneResource)
+	test.Equal(t, sidekick.Success, true)
+	test.Equal(t, sidekick.ResponseBody.Unmarshal(), test.Unmarshal(`[
+		{"id": "1", "heroId": "h1"}, {"id": "2", "heroId": "h2"}, {"id": "3", "heroId": "h3"},
+		{"id": "4", "heroId": "h4"}, {"id": "5", "heroId": "h5"}
+	]`))
+
+	hero := got["hero"].(restql.DoneResource)
+	test.Equal(t, hero.Success, true)
+	test.Equal(t, len(hero.ResponseBody.Unmarshal().([]interface{})), 5)
+
+	test.Equal(t, len(requested), 4)
+}
+
+func TestMergeBatches(t *testing.T) {
+	newBatch := func(status int, body string) restql.DoneResource {
+		return restql.DoneResource{
+			Status:       status,
+			Success:      status < 400,
+			URL:          "http://hero.io/api",
+			ResponseTime: int64(status / 10),
+			ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(body)),
+		}
+	}
+
+	t.Run("should concatenate lists and merge objects", func(t *testing.T) {
+		got := runner.MergeBatches(test.NoOpLogger, []restql.DoneResource{
+			newBatch(200, `{"items": [1, 2], "total": 2}`),
+			newBatch(201, `{"items": [3], "total": 1}`),
+		})
+
+		test.Equal(t, got.Status, 200)
+		test.Equal(t, got.Success, true)
+		test.Equal(t, got.ResponseTime, int64(20))
+		test.Equal(t, got.ResponseBody.Unmarshal(), test.Unmarshal(`{"items": [1, 2, 3], "total": 1}`))
+	})
+
+	t.Run("should return the failed batch", func(t *testing.T) {
+		failed := newBatch(500, `{"error": "boom"}`)
+
+		got := runner.MergeBatches(test.NoOpLogger, []restql.DoneResource{newBatch(200, `[1]`), failed, newBatch(200, `[2]`)})
+
+		test.Equal(t, got, failed)
+	})
+}
@@ -55,6 +55,14 @@ func resolveStatement(stmt interface{}, doneResources domain.Resources) interfac
 			headers[name] = headerValue
 		}
 
+		stmt.When = resolveCondition(stmt.When, doneResources)
+
+		if stmt.OnError != nil {
+			stmt.OnError = &domain.OnError{Default: resolveValue(stmt.OnError.Default, doneResources, resolverOptions{})}
+		}
+
+		return stmt
+
 	case []interface{}:
 		result := make([]interface{}, len(stmt))
 		for i, s := range stmt {
@@ -81,6 +89,18 @@ func stringify(value interface{}) (string, error) {
 	}
 }
 
+func resolveCondition(condition *domain.Condition, doneResources domain.Resources) *domain.Condition {
+	if condition == nil {
+		return nil
+	}
+
+	return &domain.Condition{
+		Left:     resolveValue(condition.Left, doneResources, resolverOptions{}),
+		Operator: condition.Operator,
+		Right:    resolveValue(condition.Right, doneResources, resolverOptions{}),
+	}
+}
+
 type resolverOptions struct {
 	explode bool
 }
@@ -91,6 +111,8 @@ func resolveValue(value interface{}, doneResources domain.Resources, options res
 		return resolveChainParam(param, doneResources)
 	case domain.NoExplode:
 		return resolveValue(param.Target(), doneResources, resolverOptions{explode: false})
+	case domain.Template:
+		return resolveTemplateParam(param, doneResources)
 	case domain.Function:
 		return param.Map(func(target interface{}) interface{} {
 			return resolveValue(target, doneResources, options)
@@ -104,6 +126,55 @@ func resolveValue(value interface{}, doneResources domain.Resources, options res
 	}
 }
 
+func resolveTemplateParam(template domain.Template, doneResources domain.Resources) interface{} {
+	parts := make(domain.Template, len(template))
+	for i, part := range template {
+		chain, ok := part.(domain.Chain)
+		if !ok {
+			parts[i] = part
+			continue
+		}
+
+		value := resolveChainParam(chain, doneResources)
+		if value == nil {
+			return nil
+		}
+		if value == EmptyChained {
+			return EmptyChained
+		}
+
+		parts[i] = value
+	}
+
+	rendered, ok := parts.Render()
+	if !ok {
+		return nil
+	}
+
+	if list, ok := rendered.([]interface{}); ok {
+		return markEmptyChainedItems(list)
+	}
+
+	return rendered
+}
+
+// markEmptyChainedItems replaces the rendered strings built from
+// failed multiplexed responses by the EmptyChained token.
+func markEmptyChainedItems(list []interface{}) []interface{} {
+	for i, item := range list {
+		switch item := item.(type) {
+		case string:
+			if strings.Contains(item, EmptyChained) {
+				list[i] = EmptyChained
+			}
+		case []interface{}:
+			list[i] = markEmptyChainedItems(item)
+		}
+	}
+
+	return list
+}
+
 func resolveObjectParam(objectParam map[string]interface{}, doneResources domain.Resources, options resolverOptions) interface{} {
 	result := make(map[string]interface{})
 
@@ -217,7 +288,7 @@ func resolveWithMultiplexedRequests(path []string, doneRequests restql.DoneResou
 }
 
 func resolveWithSingleRequest(path []string, done restql.DoneResource) interface{} {
-	if done.Status < 200 || done.Status >= 400 {
+	if done.Skipped || (!done.Fallback && (done.Status < 200 || done.Status >= 400)) {
 		return EmptyChained
 	}
 
@@ -305,6 +376,25 @@ func validateStatement(stmt interface{}, resources domain.Resources) error {
 				return err
 			}
 		}
+
+		if stmt.When != nil {
+			err := validateParam(stmt.When.Left, resources)
+			if err != nil {
+				return err
+			}
+
+			err = validateParam(stmt.When.Right, resources)
+			if err != nil {
+				return err
+			}
+		}
+
+		if stmt.OnError != nil {
+			err := validateParam(stmt.OnError.Default, resources)
+			if err != nil {
+				return err
+			}
+		}
 		return nil
 	case []interface{}:
 		for _, s := range stmt {
@@ -325,6 +415,8 @@ func validateParam(value interface{}, resources domain.Resources) error {
 		return validateChainParam(param, resources)
 	case domain.Function:
 		return validateParam(param.Target(), resources)
+	case domain.Template:
+		return validateListParam(param, resources)
 	case []interface{}:
 		return validateListParam(param, resources)
 	case map[string]interface{}:
@@ -268,6 +268,42 @@ func TestResolveChainedValues(t *testing.T) {
 			domain.Resources{"resource-name": domain.Statement{Resource: "resource-name", With: domain.Params{Values: map[string]interface{}{"info": domain.NoExplode{Value: domain.NoMultiplex{Value: map[string]interface{}{"weapon": domain.Chain{"done-resource", "hero", "weapons"}}}}}}}},
 			domain.Resources{"done-resource": restql.DoneResource{Status: 200, ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{"hero": {"weapons": ["batarang", "batbelt"]}}`))}},
 		},
+		{
+			"Returns a statement with value resolved from done resource fallback",
+			domain.Resources{"resource-name": domain.Statement{Resource: "resource-name", With: domain.Params{Values: map[string]interface{}{"id": []interface{}{}}}}},
+			domain.Resources{"resource-name": domain.Statement{Resource: "resource-name", With: domain.Params{Values: map[string]interface{}{"id": domain.Chain{"done-resource", "items"}}}}},
+			domain.Resources{"done-resource": restql.DoneResource{Status: 500, Fallback: true, ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, map[string]interface{}{"items": []interface{}{}})}},
+		},
+		{
+			"Returns a statement with template string resolved",
+			domain.Resources{"resource-name": domain.Statement{Resource: "resource-name", Headers: map[string]interface{}{"X-Hero": "hero-1"}, With: domain.Params{Values: map[string]interface{}{"id": "1-x"}}}},
+			domain.Resources{"resource-name": domain.Statement{Resource: "resource-name", Headers: map[string]interface{}{"X-Hero": domain.Template{"hero-", domain.Chain{"done-resource", "id"}}}, With: domain.Params{Values: map[string]interface{}{"id": domain.Template{domain.Chain{"done-resource", "id"}, "-x"}}}}},
+			domain.Resources{"done-resource": restql.DoneResource{Status: 200, ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{"id": 1}`))}},
+		},
+		{
+			"Returns a statement with template string resolved for each multiplexed value",
+			domain.Resources{"resource-name": domain.Statement{Resource: "resource-name", With: domain.Params{Values: map[string]interface{}{"id": []interface{}{runner.EmptyChained, "id-2"}}}}},
+			domain.Resources{"resource-name": domain.Statement{Resource: "resource-name", With: domain.Params{Values: map[string]interface{}{"id": domain.Template{"id-", domain.Chain{"done-resource", "id"}}}}}},
+			domain.Resources{"done-resource": restql.DoneResources{restql.DoneResource{Status: 404, ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal("{}"))}, restql.DoneResource{Status: 200, ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{"id": 2}`))}}},
+		},
+		{
+			"Returns a statement with EmptyChained as template value if done-resource failed",
+			domain.Resources{"resource-name": domain.Statement{Resource: "resource-name", With: domain.Params{Values: map[string]interface{}{"id": runner.EmptyChained}}}},
+			domain.Resources{"resource-name": domain.Statement{Resource: "resource-name", With: domain.Params{Values: map[string]interface{}{"id": domain.Template{"id-", domain.Chain{"done-resource", "id"}}}}}},
+			domain.Resources{"done-resource": restql.DoneResource{Status: 500, ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal("{}"))}},
+		},
+		{
+			"Returns a statement with when condition resolved",
+			domain.Resources{"resource-name": domain.Statement{Resource: "resource-name", When: &domain.Condition{Left: "vip", Operator: domain.EqualOperator, Right: "vip"}}},
+			domain.Resources{"resource-name": domain.Statement{Resource: "resource-name", When: &domain.Condition{Left: domain.Chain{"done-resource", "tier"}, Operator: domain.EqualOperator, Right: "vip"}}},
+			domain.Resources{"done-resource": restql.DoneResource{Status: 200, ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{"tier": "vip"}`))}},
+		},
+		{
+			"Returns a statement with on-error default value resolved",
+			domain.Resources{"resource-name": domain.Statement{Resource: "resource-name", OnError: &domain.OnError{Default: map[string]interface{}{"id": "abcdef"}}}},
+			domain.Resources{"resource-name": domain.Statement{Resource: "resource-name", OnError: &domain.OnError{Default: map[string]interface{}{"id": domain.Chain{"done-resource", "id"}}}}},
+			domain.Resources{"done-resource": restql.DoneResource{Status: 200, ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{"id": "abcdef"}`))}},
+		},
 	}
 
 	for _, tt := range tests {
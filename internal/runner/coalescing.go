@@ -0,0 +1,105 @@
+package runner
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+)
+
+type coalescedResult struct {
+	response restql.HTTPResponse
+	err      error
+}
+
+// doCoalesced executes the request with the HTTP client, sharing a single
+// call among identical in-flight GET requests when coalescing is enabled
+// and the statement resource mapping does not opt out of it. It returns
+// true when the response was shared, i.e. the request was not the only
+// one waiting for the call.
+// The shared call is bounded by the request timeout alone, so a request
+// giving up does not cancel it for the others.
+func (e Executor) doCoalesced(ctx context.Context, statement domain.Statement, request restql.HTTPRequest, queryCtx restql.QueryContext) (restql.HTTPResponse, bool, error) {
+	mapping := queryCtx.Mappings[statement.Resource]
+	if e.coalescer == nil || request.Method != http.MethodGet || mapping.HasOption(restql.MappingOptionNoCoalescing) {
+		response, err := e.client.Do(ctx, request)
+		return response, false, err
+	}
+
+	key := coalescingKey(request)
+	ch := e.coalescer.DoChan(key, func() (interface{}, error) {
+		callCtx, cancel := context.WithCancel(detachedContext{ctx})
+		if request.Timeout > 0 {
+			callCtx, cancel = context.WithTimeout(detachedContext{ctx}, request.Timeout)
+		}
+		defer cancel()
+
+		response, err := e.client.Do(callCtx, request)
+		return coalescedResult{response: response, err: err}, nil
+	})
+
+	select {
+	case r := <-ch:
+		result := r.Val.(coalescedResult)
+		if !r.Shared {
+			return result.response, false, result.err
+		}
+
+		log := restql.GetLogger(ctx)
+		log.Debug("request coalesced with identical in-flight request", "resource", statement.Resource, "url", requestURL(request))
+
+		return copyResponse(log, result.response), true, result.err
+	case <-ctx.Done():
+		return restql.HTTPResponse{URL: requestURL(request), StatusCode: http.StatusRequestTimeout}, false, domain.ErrRequestTimeout
+	}
+}
+
+// coalescingKey identifies requests that would produce the same
+// upstream call, considering the method, URL, headers and timeout.
+func coalescingKey(request restql.HTTPRequest) string {
+	headers := make([]string, 0, len(request.Headers))
+	for k, v := range request.Headers {
+		headers = append(headers, strings.ToLower(k)+":"+v)
+	}
+	sort.Strings(headers)
+
+	return request.Method + " " + requestURL(request) + " " + request.Timeout.String() + "\n" + strings.Join(headers, "\n")
+}
+
+// detachedContext keeps the values of the request that started a
+// shared call, like the logger and the tenant, but not its deadline
+// nor cancellation, since the call is also awaited by other requests.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+
+func (d detachedContext) Value(key interface{}) interface{} {
+	return d.parent.Value(key)
+}
+
+// copyResponse gives each request sharing a call its own
+// response body and headers, since both are modified
+// when the statement result is processed.
+func copyResponse(log restql.Logger, response restql.HTTPResponse) restql.HTTPResponse {
+	if response.Body != nil {
+		response.Body = restql.NewResponseBodyFromBytes(log, response.Body.Bytes())
+	}
+
+	if response.Headers != nil {
+		headers := make(restql.Headers, len(response.Headers))
+		for k, v := range response.Headers {
+			headers[k] = v
+		}
+		response.Headers = headers
+	}
+
+	return response
+}
@@ -0,0 +1,143 @@
+package runner
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+)
+
+// SkipUnmetConditions takes a Resource collection with resolved
+// `when` and `depends-on` clauses and split it into the statements
+// that should be executed and the skipped results of those which
+// `when` condition or `depends-on` condition was not met.
+// A multiplexed statement is only skipped when the `when` condition
+// of all of its statements is unmet, the remaining ones are
+// skipped individually by the Executor.
+func SkipUnmetConditions(log restql.Logger, resources domain.Resources) (domain.Resources, domain.Resources) {
+	skipped := make(domain.Resources)
+
+	for resourceID, stmt := range resources {
+		s, ok := findStatement(stmt)
+		if !ok {
+			continue
+		}
+
+		options := DoneResourceOptions{IgnoreErrors: s.IgnoreErrors}
+		switch {
+		case !isAnyConditionMet(stmt):
+			log.Debug("request execution skipped due to unmet condition", "resource", s.Resource, "method", s.Method)
+			skipped[resourceID] = NewSkippedResponse(log, unmetConditionReason, options)
+		case s.DependsOn.Condition != "" && !s.DependsOn.Resolved:
+			log.Debug("request execution skipped due to unmet dependency condition", "resource", s.Resource, "method", s.Method)
+			skipped[resourceID] = NewSkippedResponse(log, unmetDependsOnReason(s), options)
+		default:
+			continue
+		}
+
+		delete(resources, resourceID)
+	}
+
+	return resources, skipped
+}
+
+func findStatement(stmt interface{}) (domain.Statement, bool) {
+	switch stmt := stmt.(type) {
+	case domain.Statement:
+		return stmt, true
+	case []interface{}:
+		for _, s := range stmt {
+			if found, ok := findStatement(s); ok {
+				return found, true
+			}
+		}
+		return domain.Statement{}, false
+	default:
+		return domain.Statement{}, false
+	}
+}
+
+func isAnyConditionMet(stmt interface{}) bool {
+	switch stmt := stmt.(type) {
+	case domain.Statement:
+		return IsConditionMet(stmt.When)
+	case []interface{}:
+		for _, s := range stmt {
+			if isAnyConditionMet(s) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// IsConditionMet evaluates a resolved `when` clause.
+// A statement without condition is always executed, while
+// one with an operand that could not be resolved never is.
+func IsConditionMet(condition *domain.Condition) bool {
+	if condition == nil {
+		return true
+	}
+
+	if condition.Left == EmptyChained || condition.Right == EmptyChained {
+		return false
+	}
+
+	switch condition.Operator {
+	case domain.EqualOperator:
+		return isEqual(condition.Left, condition.Right)
+	case domain.NotEqualOperator:
+		return !isEqual(condition.Left, condition.Right)
+	default:
+		return isTruthy(condition.Left)
+	}
+}
+
+func isEqual(left, right interface{}) bool {
+	if reflect.DeepEqual(left, right) {
+		return true
+	}
+
+	if !isScalar(left) || !isScalar(right) {
+		return false
+	}
+
+	return fmt.Sprintf("%v", left) == fmt.Sprintf("%v", right)
+}
+
+func isScalar(value interface{}) bool {
+	switch value.(type) {
+	case string, bool, int, float64:
+		return true
+	default:
+		return false
+	}
+}
+
+func isTruthy(value interface{}) bool {
+	switch value := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return value
+	case string:
+		b, err := strconv.ParseBool(value)
+		if err == nil {
+			return b
+		}
+
+		return value != ""
+	case []interface{}:
+		return len(value) > 0
+	case map[string]interface{}:
+		return len(value) > 0
+	case domain.Variable, domain.Chain:
+		return false
+	default:
+		return true
+	}
+}
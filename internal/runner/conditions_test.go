@@ -0,0 +1,121 @@
+package runner_test
+
+import (
+	"testing"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/internal/runner"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+	"github.com/b2wdigital/restQL-golang/v6/test"
+)
+
+func TestIsConditionMet(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition *domain.Condition
+		expected  bool
+	}{
+		{"statement without condition", nil, true},
+		{"existing value", &domain.Condition{Left: "123"}, true},
+		{"missing value", &domain.Condition{Left: nil}, false},
+		{"empty string", &domain.Condition{Left: ""}, false},
+		{"boolean true", &domain.Condition{Left: true}, true},
+		{"boolean false", &domain.Condition{Left: false}, false},
+		{"string boolean false", &domain.Condition{Left: "false"}, false},
+		{"empty list", &domain.Condition{Left: []interface{}{}}, false},
+		{"empty chained value", &domain.Condition{Left: runner.EmptyChained}, false},
+		{"equal strings", &domain.Condition{Left: "vip", Operator: domain.EqualOperator, Right: "vip"}, true},
+		{"different strings", &domain.Condition{Left: "vip", Operator: domain.EqualOperator, Right: "guest"}, false},
+		{"equal boolean and string", &domain.Condition{Left: "true", Operator: domain.EqualOperator, Right: true}, true},
+		{"equal numbers of different types", &domain.Condition{Left: float64(10), Operator: domain.EqualOperator, Right: 10}, true},
+		{"equal missing values", &domain.Condition{Left: nil, Operator: domain.EqualOperator, Right: nil}, true},
+		{"not equal strings", &domain.Condition{Left: "vip", Operator: domain.NotEqualOperator, Right: "guest"}, true},
+		{"not equal with empty chained value", &domain.Condition{Left: runner.EmptyChained, Operator: domain.NotEqualOperator, Right: "guest"}, false},
+		{"not equal with empty chained right value", &domain.Condition{Left: "vip", Operator: domain.NotEqualOperator, Right: runner.EmptyChained}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := runner.IsConditionMet(tt.condition)
+			test.Equal(t, got, tt.expected)
+		})
+	}
+}
+
+func TestSkipUnmetConditions(t *testing.T) {
+	t.Run("should split statements with unmet condition into skipped results", func(t *testing.T) {
+		heroStatement := domain.Statement{Method: "from", Resource: "hero", When: &domain.Condition{Left: "123"}}
+		loyaltyStatement := domain.Statement{Method: "from", Resource: "loyalty", IgnoreErrors: true, When: &domain.Condition{Left: nil}}
+		sidekickStatement := domain.Statement{Method: "from", Resource: "sidekick", When: &domain.Condition{Left: "guest", Operator: domain.EqualOperator, Right: "vip"}}
+
+		resources := domain.Resources{
+			"hero":     heroStatement,
+			"loyalty":  loyaltyStatement,
+			"sidekick": []interface{}{sidekickStatement, sidekickStatement},
+		}
+
+		expectedAvailable := domain.Resources{"hero": heroStatement}
+		expectedSkipped := domain.Resources{
+			"loyalty": restql.DoneResource{
+				Status:       204,
+				Success:      true,
+				Skipped:      true,
+				SkipReason:   "when condition not met",
+				IgnoreErrors: true,
+				ResponseBody: restql.NewResponseBodyFromBytes(test.NoOpLogger, nil),
+			},
+			"sidekick": restql.DoneResource{
+				Status:       204,
+				Success:      true,
+				Skipped:      true,
+				SkipReason:   "when condition not met",
+				ResponseBody: restql.NewResponseBodyFromBytes(test.NoOpLogger, nil),
+			},
+		}
+
+		available, skipped := runner.SkipUnmetConditions(test.NoOpLogger, resources)
+
+		test.Equal(t, available, expectedAvailable)
+		test.Equal(t, skipped, expectedSkipped)
+	})
+
+	t.Run("should keep multiplexed statements when some condition is met", func(t *testing.T) {
+		metStatement := domain.Statement{Method: "from", Resource: "sidekick", When: &domain.Condition{Left: "vip", Operator: domain.EqualOperator, Right: "vip"}}
+		unmetStatement := domain.Statement{Method: "from", Resource: "sidekick", When: &domain.Condition{Left: "guest", Operator: domain.EqualOperator, Right: "vip"}}
+
+		resources := domain.Resources{"sidekick": []interface{}{unmetStatement, metStatement}}
+
+		available, skipped := runner.SkipUnmetConditions(test.NoOpLogger, resources)
+
+		test.Equal(t, available, domain.Resources{"sidekick": []interface{}{unmetStatement, metStatement}})
+		test.Equal(t, skipped, domain.Resources{})
+	})
+
+	t.Run("should skip statements with unmet depends-on condition", func(t *testing.T) {
+		refundStatement := domain.Statement{Method: "to", Resource: "refund", DependsOn: domain.DependsOn{Targets: []string{"payment"}, Condition: domain.DependsOnFailure}}
+		receiptStatement := domain.Statement{Method: "to", Resource: "receipt", DependsOn: domain.DependsOn{Targets: []string{"payment"}, Condition: domain.DependsOnSuccess, Resolved: true}}
+		orderStatement := domain.Statement{Method: "to", Resource: "order", DependsOn: domain.DependsOn{Targets: []string{"payment"}}}
+
+		resources := domain.Resources{
+			"refund":  refundStatement,
+			"receipt": receiptStatement,
+			"order":   orderStatement,
+		}
+
+		expectedAvailable := domain.Resources{"receipt": receiptStatement, "order": orderStatement}
+		expectedSkipped := domain.Resources{
+			"refund": restql.DoneResource{
+				Status:       204,
+				Success:      true,
+				Skipped:      true,
+				SkipReason:   "depends-on condition not met: payment on failure",
+				ResponseBody: restql.NewResponseBodyFromBytes(test.NoOpLogger, nil),
+			},
+		}
+
+		available, skipped := runner.SkipUnmetConditions(test.NoOpLogger, resources)
+
+		test.Equal(t, available, expectedAvailable)
+		test.Equal(t, skipped, expectedSkipped)
+	})
+}
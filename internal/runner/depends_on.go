@@ -11,8 +11,13 @@ import (
 // references an unknown statement.
 var ErrInvalidDependsOnTarget = errors.New("depends-on targets an unknown resource")
 
+// ErrInvalidDependsOnCondition represents an error when a depends-on
+// condition is neither success nor failure.
+var ErrInvalidDependsOnCondition = errors.New("depends-on has an unknown condition")
+
 // ResolveDependsOn takes an unresolved Resource collection and
-// find if a resource dependency was successfully resolved
+// find if the resource dependencies were successfully resolved
+// or, when the clause has a condition, if all of them meet it.
 func ResolveDependsOn(resources domain.Resources, doneResources domain.Resources) domain.Resources {
 	for resourceID, stmt := range resources {
 		resources[resourceID] = resolveDependsOnIntoStatement(stmt, doneResources)
@@ -24,14 +29,13 @@ func ResolveDependsOn(resources domain.Resources, doneResources domain.Resources
 func resolveDependsOnIntoStatement(stmt interface{}, doneResources domain.Resources) interface{} {
 	switch stmt := stmt.(type) {
 	case domain.Statement:
-		target := stmt.DependsOn.Target
-		if target == "" {
-			stmt.DependsOn.Resolved = true
-			return stmt
+		resolved := true
+		for _, target := range stmt.DependsOn.Targets {
+			dr := doneResources[domain.ResourceID(target)]
+			resolved = resolved && isDependencyMet(stmt.DependsOn.Condition, dr)
 		}
 
-		dr := doneResources[domain.ResourceID(target)]
-		stmt.DependsOn.Resolved = isResolved(dr)
+		stmt.DependsOn.Resolved = resolved
 		return stmt
 	case []interface{}:
 		result := make([]interface{}, len(stmt))
@@ -44,10 +48,21 @@ func resolveDependsOnIntoStatement(stmt interface{}, doneResources domain.Resour
 	}
 }
 
+func isDependencyMet(condition string, doneResource interface{}) bool {
+	switch condition {
+	case domain.DependsOnSuccess:
+		return hasSucceeded(doneResource)
+	case domain.DependsOnFailure:
+		return hasFailed(doneResource)
+	default:
+		return isResolved(doneResource)
+	}
+}
+
 func isResolved(doneResource interface{}) bool {
 	switch done := doneResource.(type) {
 	case restql.DoneResource:
-		return done.IgnoreErrors || (done.Status >= 200 && done.Status <= 399)
+		return !done.Skipped && (done.IgnoreErrors || done.Fallback || (done.Status >= 200 && done.Status <= 399))
 	case restql.DoneResources:
 		resolved := false
 		for _, d := range done {
@@ -59,8 +74,43 @@ func isResolved(doneResource interface{}) bool {
 	}
 }
 
+// hasSucceeded checks if the upstream responded with success to
+// every request, regardless of `ignore-errors` and `on-error`.
+func hasSucceeded(doneResource interface{}) bool {
+	switch done := doneResource.(type) {
+	case restql.DoneResource:
+		return !done.Skipped && done.Status >= 200 && done.Status <= 399
+	case restql.DoneResources:
+		succeeded := len(done) > 0
+		for _, d := range done {
+			succeeded = succeeded && hasSucceeded(d)
+		}
+		return succeeded
+	default:
+		return false
+	}
+}
+
+// hasFailed checks if the upstream responded with an error to any
+// request, regardless of `ignore-errors` and `on-error`.
+func hasFailed(doneResource interface{}) bool {
+	switch done := doneResource.(type) {
+	case restql.DoneResource:
+		return !done.Skipped && (done.Status < 200 || done.Status > 399)
+	case restql.DoneResources:
+		failed := false
+		for _, d := range done {
+			failed = failed || hasFailed(d)
+		}
+		return failed
+	default:
+		return false
+	}
+}
+
 // ValidateDependsOnTarget returns an error if a depends-on
-// target references an unknown statement.
+// target references an unknown statement or the condition
+// is not supported.
 func ValidateDependsOnTarget(resources domain.Resources) error {
 	for _, stmt := range resources {
 		err := validateDependsOnIntoStatement(stmt, resources)
@@ -75,17 +125,19 @@ func ValidateDependsOnTarget(resources domain.Resources) error {
 func validateDependsOnIntoStatement(stmt interface{}, resources domain.Resources) error {
 	switch stmt := stmt.(type) {
 	case domain.Statement:
-		target := stmt.DependsOn.Target
-		if target == "" {
-			return nil
+		for _, target := range stmt.DependsOn.Targets {
+			_, found := resources[domain.ResourceID(target)]
+			if !found {
+				return fmt.Errorf("%w: %s", ErrInvalidDependsOnTarget, target)
+			}
 		}
 
-		_, found := resources[domain.ResourceID(target)]
-		if !found {
-			return fmt.Errorf("%w: %s", ErrInvalidDependsOnTarget, target)
+		switch stmt.DependsOn.Condition {
+		case "", domain.DependsOnSuccess, domain.DependsOnFailure:
+			return nil
+		default:
+			return fmt.Errorf("%w: %s", ErrInvalidDependsOnCondition, stmt.DependsOn.Condition)
 		}
-
-		return nil
 	case []interface{}:
 		for _, s := range stmt {
 			err := validateDependsOnIntoStatement(s, resources)
@@ -32,11 +32,11 @@ func TestResolveDependsOn(t *testing.T) {
 			"Returns a statement with depends on resolved if done-resource status code is in 200 >= status >= 399",
 			domain.Resources{
 				"hero":     domain.Statement{Method: "from", Resource: "hero", DependsOn: domain.DependsOn{Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "abcdef12345"}}},
-				"sidekick": domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Target: "hero", Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
+				"sidekick": domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Targets: []string{"hero"}, Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
 			},
 			domain.Resources{
 				"hero":     domain.Statement{Method: "from", Resource: "hero", With: domain.Params{Values: map[string]interface{}{"id": "abcdef12345"}}},
-				"sidekick": domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Target: "hero"}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
+				"sidekick": domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Targets: []string{"hero"}}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
 			},
 			domain.Resources{"hero": restql.DoneResource{Status: 201, ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal("{}"))}},
 		},
@@ -44,11 +44,11 @@ func TestResolveDependsOn(t *testing.T) {
 			"Returns a statement with depends on not resolved if done-resource status code is not successful",
 			domain.Resources{
 				"hero":     domain.Statement{Method: "from", Resource: "hero", DependsOn: domain.DependsOn{Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "abcdef12345"}}},
-				"sidekick": domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Target: "hero", Resolved: false}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
+				"sidekick": domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Targets: []string{"hero"}, Resolved: false}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
 			},
 			domain.Resources{
 				"hero":     domain.Statement{Method: "from", Resource: "hero", With: domain.Params{Values: map[string]interface{}{"id": "abcdef12345"}}},
-				"sidekick": domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Target: "hero"}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
+				"sidekick": domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Targets: []string{"hero"}}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
 			},
 			domain.Resources{"hero": restql.DoneResource{Status: 408, ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal("{}"))}},
 		},
@@ -56,23 +56,35 @@ func TestResolveDependsOn(t *testing.T) {
 			"Returns a statement with depends on resolved if done-resource status code is not successful but has ignore-errors",
 			domain.Resources{
 				"hero":     domain.Statement{Method: "from", Resource: "hero", DependsOn: domain.DependsOn{Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "abcdef12345"}}},
-				"sidekick": domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Target: "hero", Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
+				"sidekick": domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Targets: []string{"hero"}, Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
 			},
 			domain.Resources{
 				"hero":     domain.Statement{Method: "from", Resource: "hero", With: domain.Params{Values: map[string]interface{}{"id": "abcdef12345"}}},
-				"sidekick": domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Target: "hero"}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
+				"sidekick": domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Targets: []string{"hero"}}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
 			},
 			domain.Resources{"hero": restql.DoneResource{Status: 408, IgnoreErrors: true, ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal("{}"))}},
 		},
+		{
+			"Returns a statement with depends on resolved if done-resource is not successful but has a fallback value",
+			domain.Resources{
+				"hero":     domain.Statement{Method: "from", Resource: "hero", DependsOn: domain.DependsOn{Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "abcdef12345"}}},
+				"sidekick": domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Targets: []string{"hero"}, Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
+			},
+			domain.Resources{
+				"hero":     domain.Statement{Method: "from", Resource: "hero", With: domain.Params{Values: map[string]interface{}{"id": "abcdef12345"}}},
+				"sidekick": domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Targets: []string{"hero"}}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
+			},
+			domain.Resources{"hero": restql.DoneResource{Status: 503, Fallback: true, ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal("{}"))}},
+		},
 		{
 			"Returns a statements with depends on resolved if at least one of the multiplexed done-resource status code is in 200 >= status >= 399",
 			domain.Resources{
 				"hero":     domain.Statement{Method: "from", Resource: "hero", DependsOn: domain.DependsOn{Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "abcdef12345"}}},
-				"sidekick": domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Target: "hero", Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
+				"sidekick": domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Targets: []string{"hero"}, Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
 			},
 			domain.Resources{
 				"hero":     domain.Statement{Method: "from", Resource: "hero", With: domain.Params{Values: map[string]interface{}{"id": "abcdef12345"}}},
-				"sidekick": domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Target: "hero"}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
+				"sidekick": domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Targets: []string{"hero"}}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
 			},
 			domain.Resources{"hero": restql.DoneResources{
 				restql.DoneResource{Status: 500, ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal("{}"))},
@@ -85,15 +97,15 @@ func TestResolveDependsOn(t *testing.T) {
 			domain.Resources{
 				"hero": domain.Statement{Method: "from", Resource: "hero", DependsOn: domain.DependsOn{Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "abcdef12345"}}},
 				"sidekick": []interface{}{
-					domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Target: "hero", Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
-					domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Target: "hero", Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "5678xpot"}}},
+					domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Targets: []string{"hero"}, Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
+					domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Targets: []string{"hero"}, Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "5678xpot"}}},
 				},
 			},
 			domain.Resources{
 				"hero": domain.Statement{Method: "from", Resource: "hero", With: domain.Params{Values: map[string]interface{}{"id": "abcdef12345"}}},
 				"sidekick": []interface{}{
-					domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Target: "hero"}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
-					domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Target: "hero"}, With: domain.Params{Values: map[string]interface{}{"id": "5678xpot"}}},
+					domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Targets: []string{"hero"}}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
+					domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Targets: []string{"hero"}}, With: domain.Params{Values: map[string]interface{}{"id": "5678xpot"}}},
 				},
 			},
 			domain.Resources{"hero": restql.DoneResource{Status: 201, ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal("{}"))}},
@@ -103,15 +115,15 @@ func TestResolveDependsOn(t *testing.T) {
 			domain.Resources{
 				"hero": domain.Statement{Method: "from", Resource: "hero", DependsOn: domain.DependsOn{Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "abcdef12345"}}},
 				"sidekick": []interface{}{
-					domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Target: "hero", Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
-					domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Target: "hero", Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "5678xpot"}}},
+					domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Targets: []string{"hero"}, Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
+					domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Targets: []string{"hero"}, Resolved: true}, With: domain.Params{Values: map[string]interface{}{"id": "5678xpot"}}},
 				},
 			},
 			domain.Resources{
 				"hero": domain.Statement{Method: "from", Resource: "hero", With: domain.Params{Values: map[string]interface{}{"id": "abcdef12345"}}},
 				"sidekick": []interface{}{
-					domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Target: "hero"}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
-					domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Target: "hero"}, With: domain.Params{Values: map[string]interface{}{"id": "5678xpot"}}},
+					domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Targets: []string{"hero"}}, With: domain.Params{Values: map[string]interface{}{"id": "zyxv98765"}}},
+					domain.Statement{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Targets: []string{"hero"}}, With: domain.Params{Values: map[string]interface{}{"id": "5678xpot"}}},
 				},
 			},
 			domain.Resources{"hero": restql.DoneResources{
@@ -130,6 +142,47 @@ func TestResolveDependsOn(t *testing.T) {
 	}
 }
 
+func TestResolveDependsOnWithMultipleTargetsAndConditions(t *testing.T) {
+	doneResources := domain.Resources{
+		"payment": restql.DoneResource{Status: 402, IgnoreErrors: true},
+		"reserve": restql.DoneResource{Status: 201},
+		"notify":  restql.DoneResource{Status: 204, Success: true, Skipped: true},
+		"sidekick": restql.DoneResources{
+			restql.DoneResource{Status: 200},
+			restql.DoneResource{Status: 500, Fallback: true},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		dependsOn domain.DependsOn
+		expected  bool
+	}{
+		{"all targets resolved", domain.DependsOn{Targets: []string{"payment", "reserve"}}, true},
+		{"one target not resolved", domain.DependsOn{Targets: []string{"reserve", "notify"}}, false},
+		{"every target succeeded", domain.DependsOn{Targets: []string{"reserve"}, Condition: domain.DependsOnSuccess}, true},
+		{"target with ignore-errors failed", domain.DependsOn{Targets: []string{"payment"}, Condition: domain.DependsOnSuccess}, false},
+		{"target failed", domain.DependsOn{Targets: []string{"payment"}, Condition: domain.DependsOnFailure}, true},
+		{"one of the targets did not fail", domain.DependsOn{Targets: []string{"payment", "reserve"}, Condition: domain.DependsOnFailure}, false},
+		{"skipped target neither succeeded nor failed", domain.DependsOn{Targets: []string{"notify"}, Condition: domain.DependsOnFailure}, false},
+		{"multiplexed target with a failed request", domain.DependsOn{Targets: []string{"sidekick"}, Condition: domain.DependsOnFailure}, true},
+		{"multiplexed target with a failed request did not succeed", domain.DependsOn{Targets: []string{"sidekick"}, Condition: domain.DependsOnSuccess}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resources := domain.Resources{"order": domain.Statement{Method: "delete", Resource: "order", DependsOn: tt.dependsOn}}
+
+			expectedDependsOn := tt.dependsOn
+			expectedDependsOn.Resolved = tt.expected
+			expected := domain.Resources{"order": domain.Statement{Method: "delete", Resource: "order", DependsOn: expectedDependsOn}}
+
+			got := runner.ResolveDependsOn(resources, doneResources)
+			test.Equal(t, got, expected)
+		})
+	}
+}
+
 func TestValidateDependsOnTarget(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -154,11 +207,35 @@ func TestValidateDependsOnTarget(t *testing.T) {
 				"resource-name": domain.Statement{
 					Method:    "from",
 					Resource:  "resource-name",
-					DependsOn: domain.DependsOn{Target: "unknown"},
+					DependsOn: domain.DependsOn{Targets: []string{"unknown"}},
 					With:      domain.Params{Values: map[string]interface{}{"id": "abcdef12345"}},
 				},
 			},
 		},
+		{
+			"Fail validation if any depends on target is unknown",
+			fmt.Errorf("%w: unknown", runner.ErrInvalidDependsOnTarget),
+			domain.Resources{
+				"known-resource": domain.Statement{Method: "from", Resource: "known-resource"},
+				"resource-name": domain.Statement{
+					Method:    "from",
+					Resource:  "resource-name",
+					DependsOn: domain.DependsOn{Targets: []string{"known-resource", "unknown"}},
+				},
+			},
+		},
+		{
+			"Fail validation if depends on condition is unknown",
+			fmt.Errorf("%w: done", runner.ErrInvalidDependsOnCondition),
+			domain.Resources{
+				"known-resource": domain.Statement{Method: "from", Resource: "known-resource"},
+				"resource-name": domain.Statement{
+					Method:    "from",
+					Resource:  "resource-name",
+					DependsOn: domain.DependsOn{Targets: []string{"known-resource"}, Condition: "done"},
+				},
+			},
+		},
 		{
 			"Pass validation if depends on target known resource",
 			nil,
@@ -171,7 +248,7 @@ func TestValidateDependsOnTarget(t *testing.T) {
 				"resource-name": domain.Statement{
 					Method:    "from",
 					Resource:  "resource-name",
-					DependsOn: domain.DependsOn{Target: "known-resource"},
+					DependsOn: domain.DependsOn{Targets: []string{"known-resource"}},
 					With:      domain.Params{Values: map[string]interface{}{"id": "abcdef12345"}},
 				},
 			},
@@ -0,0 +1,133 @@
+package runner
+
+import (
+	"sort"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+)
+
+// RenderedRequest is a HTTP request built for a statement
+// in dry-run mode, along with its full URL.
+type RenderedRequest struct {
+	restql.HTTPRequest
+	URL string
+}
+
+// RenderedStatement represents the requests a statement would make.
+// When the requests have values chained to other statements it is
+// Unresolvable. Chained lists the placeholders used in place of the
+// values only known during execution, including the ones of the
+// `on-error` fallback.
+// Skipped is true if the statement `when` clause is not met.
+type RenderedStatement struct {
+	Requests     []RenderedRequest
+	Unresolvable bool
+	Chained      []string
+	Skipped      bool
+}
+
+// DryRun builds the HTTP requests of every statement exactly as
+// they would be sent by the Executor, but without sending them.
+func (r Runner) DryRun(query domain.Query, queryCtx restql.QueryContext) (map[string]RenderedStatement, error) {
+	resources, err := r.initializeResources(query)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ValidateFanOut(resources, r.options.MaxFanOut)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]RenderedStatement, len(resources))
+	for resourceID, resource := range resources {
+		rs := RenderedStatement{Requests: []RenderedRequest{}}
+
+		chained := make(map[string]bool)
+		for _, stmt := range flattenMultiplexed(resource) {
+			placeholders := chainPlaceholders(stmt)
+			for _, placeholder := range placeholders {
+				chained[placeholder] = true
+			}
+			rs.Unresolvable = rs.Unresolvable || len(placeholders) > 0
+
+			if stmt.OnError != nil {
+				for _, placeholder := range collectChainPlaceholders(stmt.OnError.Default) {
+					chained[placeholder] = true
+				}
+			}
+
+			if stmt.When != nil && isConditionResolved(stmt.When) && !IsConditionMet(stmt.When) {
+				rs.Skipped = true
+			}
+
+			request := MakeRequest(r.executor.resourceTimeout, r.executor.forwardPrefix, withChainPlaceholders(stmt), queryCtx)
+			rs.Requests = append(rs.Requests, RenderedRequest{HTTPRequest: request, URL: requestURL(request)})
+		}
+
+		for placeholder := range chained {
+			rs.Chained = append(rs.Chained, placeholder)
+		}
+		sort.Strings(rs.Chained)
+
+		result[string(resourceID)] = rs
+	}
+
+	return result, nil
+}
+
+func isConditionResolved(condition *domain.Condition) bool {
+	return len(collectChainPlaceholders(condition.Left)) == 0 && len(collectChainPlaceholders(condition.Right)) == 0
+}
+
+func chainPlaceholders(stmt domain.Statement) []string {
+	var values []interface{}
+	for _, v := range stmt.With.Values {
+		values = append(values, v)
+	}
+
+	for _, v := range stmt.Headers {
+		values = append(values, v)
+	}
+
+	if stmt.When != nil {
+		values = append(values, stmt.When.Left, stmt.When.Right)
+	}
+
+	var placeholders []string
+	for _, v := range values {
+		placeholders = append(placeholders, collectChainPlaceholders(v)...)
+	}
+
+	return placeholders
+}
+
+func collectChainPlaceholders(value interface{}) []string {
+	switch value := value.(type) {
+	case domain.Chain:
+		return []string{chainPlaceholder(value).(string)}
+	case domain.Template:
+		var result []string
+		for _, part := range value {
+			result = append(result, collectChainPlaceholders(part)...)
+		}
+		return result
+	case domain.Function:
+		return collectChainPlaceholders(value.Target())
+	case map[string]interface{}:
+		var result []string
+		for _, v := range value {
+			result = append(result, collectChainPlaceholders(v)...)
+		}
+		return result
+	case []interface{}:
+		var result []string
+		for _, v := range value {
+			result = append(result, collectChainPlaceholders(v)...)
+		}
+		return result
+	default:
+		return nil
+	}
+}
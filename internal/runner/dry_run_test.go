@@ -0,0 +1,125 @@
+package runner_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/internal/runner"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+	"github.com/b2wdigital/restQL-golang/v6/test"
+)
+
+func TestDryRun(t *testing.T) {
+	client := stubHTTPClient(func(request restql.HTTPRequest) (restql.HTTPResponse, error) {
+		t.Fatalf("unexpected upstream call: %+v", request)
+		return restql.HTTPResponse{}, nil
+	})
+	executor := runner.NewExecutor(test.NoOpLogger, client, runner.ExecutorOptions{ResourceTimeout: time.Second})
+	r := runner.NewRunner(test.NoOpLogger, executor, runner.Options{})
+
+	queryCtx := restql.QueryContext{
+		Mappings: map[string]restql.Mapping{
+			"hero":     mapping(t, "http://hero.io/api/:id"),
+			"sidekick": mapping(t, "http://sidekick.io/api"),
+			"villain":  mapping(t, "http://villain.io/api"),
+		},
+		Input: restql.QueryInput{Headers: map[string]string{"X-Tid": "abc"}},
+	}
+
+	query := domain.Query{Statements: []domain.Statement{
+		{Method: "from", Resource: "hero", Timeout: 100, With: domain.Params{Values: map[string]interface{}{"id": []interface{}{1, 2}}}},
+		{
+			Method:   "to",
+			Resource: "sidekick",
+			Headers:  map[string]interface{}{"Authorization": domain.Template{"Bearer ", domain.Chain{"hero", "token"}}},
+			With:     domain.Params{Values: map[string]interface{}{"heroId": domain.Chain{"hero", "id"}, "name": "robin"}},
+		},
+		{Method: "from", Resource: "villain", When: &domain.Condition{Left: false}, OnError: &domain.OnError{Default: map[string]interface{}{"name": domain.Chain{"hero", "name"}}}},
+	}}
+
+	expected := map[string]runner.RenderedStatement{
+		"hero": {Requests: []runner.RenderedRequest{
+			{
+				URL: "http://hero.io/api/1",
+				HTTPRequest: restql.HTTPRequest{
+					Method:  "GET",
+					Schema:  "http",
+					Host:    "hero.io",
+					Path:    "/api/1",
+					Query:   map[string]interface{}{},
+					Headers: restql.Headers{"X-Tid": "abc", "Content-Type": "application/json"},
+					Timeout: 100 * time.Millisecond,
+				},
+			},
+			{
+				URL: "http://hero.io/api/2",
+				HTTPRequest: restql.HTTPRequest{
+					Method:  "GET",
+					Schema:  "http",
+					Host:    "hero.io",
+					Path:    "/api/2",
+					Query:   map[string]interface{}{},
+					Headers: restql.Headers{"X-Tid": "abc", "Content-Type": "application/json"},
+					Timeout: 100 * time.Millisecond,
+				},
+			},
+		}},
+		"sidekick": {
+			Requests: []runner.RenderedRequest{{
+				URL: "http://sidekick.io/api",
+				HTTPRequest: restql.HTTPRequest{
+					Method:  "POST",
+					Schema:  "http",
+					Host:    "sidekick.io",
+					Path:    "/api",
+					Query:   map[string]interface{}{},
+					Body:    map[string]interface{}{"heroId": "{hero.id}", "name": "robin"},
+					Headers: restql.Headers{"X-Tid": "abc", "Authorization": "Bearer {hero.token}", "Content-Type": "application/json"},
+					Timeout: time.Second,
+				},
+			}},
+			Unresolvable: true,
+			Chained:      []string{"{hero.id}", "{hero.token}"},
+		},
+		"villain": {
+			Requests: []runner.RenderedRequest{{
+				URL: "http://villain.io/api",
+				HTTPRequest: restql.HTTPRequest{
+					Method:  "GET",
+					Schema:  "http",
+					Host:    "villain.io",
+					Path:    "/api",
+					Query:   map[string]interface{}{},
+					Headers: restql.Headers{"X-Tid": "abc", "Content-Type": "application/json"},
+					Timeout: time.Second,
+				},
+			}},
+			Chained: []string{"{hero.name}"},
+			Skipped: true,
+		},
+	}
+
+	got, err := r.DryRun(query, queryCtx)
+
+	test.VerifyError(t, err)
+	test.Equal(t, got, expected)
+}
+
+func TestDryRunMaxFanOut(t *testing.T) {
+	client := stubHTTPClient(func(request restql.HTTPRequest) (restql.HTTPResponse, error) {
+		t.Fatalf("unexpected upstream call: %+v", request)
+		return restql.HTTPResponse{}, nil
+	})
+	r := runner.NewRunner(test.NoOpLogger, runner.NewExecutor(test.NoOpLogger, client, runner.ExecutorOptions{}), runner.Options{MaxFanOut: 1})
+
+	queryCtx := restql.QueryContext{Mappings: map[string]restql.Mapping{"hero": mapping(t, "http://hero.io/api/:id")}}
+	query := domain.Query{Statements: []domain.Statement{
+		{Method: "from", Resource: "hero", With: domain.Params{Values: map[string]interface{}{"id": []interface{}{1, 2}}}},
+	}}
+
+	_, err := r.DryRun(query, queryCtx)
+
+	test.Equal(t, errors.Is(err, runner.ErrMaxFanOutExceeded), true)
+}
@@ -1,9 +1,16 @@
 package runner
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
 	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
@@ -77,6 +84,24 @@ func applyEncoderToValue(log restql.Logger, value interface{}) interface{} {
 		}
 
 		return applyFlattenEncoder(log, applyEncoderToValue(log, value.Target()))
+	case domain.Upper, domain.Lower, domain.Trim, domain.ToInt, domain.ToString,
+		domain.URLEncode, domain.SHA256, domain.Join, domain.Split, domain.HMACSHA256,
+		domain.PluginFunction:
+		fn := value.(domain.Function)
+		switch target := applyEncoderToValue(log, fn.Target()).(type) {
+		case domain.Chain:
+			return fn.Map(func(interface{}) interface{} { return target })
+		case domain.Function:
+			return target.Map(func(inner interface{}) interface{} {
+				return applyEncoderToValue(log, fn.Map(func(interface{}) interface{} { return inner }))
+			})
+		default:
+			if target == EmptyChained {
+				return target
+			}
+
+			return applyStringFunction(log, fn, target)
+		}
 	case domain.Function:
 		return value.Map(func(target interface{}) interface{} {
 			return applyEncoderToValue(log, target)
@@ -137,3 +162,139 @@ func flatten(ii []interface{}) []interface{} {
 
 	return res
 }
+
+func applyStringFunction(log restql.Logger, fn domain.Function, value interface{}) interface{} {
+	switch fn := fn.(type) {
+	case domain.Join:
+		return applyJoinEncoder(value, stringifyArgument(fn.Argument(domain.JoinArgSeparator)))
+	case domain.Split:
+		separator := stringifyArgument(fn.Argument(domain.SplitArgSeparator))
+		return mapItems(value, func(v interface{}) interface{} {
+			parts := strings.Split(stringifyValue(v), separator)
+			result := make([]interface{}, len(parts))
+			for i, p := range parts {
+				result[i] = p
+			}
+			return result
+		})
+	case domain.Upper:
+		return mapItems(value, func(v interface{}) interface{} { return strings.ToUpper(stringifyValue(v)) })
+	case domain.Lower:
+		return mapItems(value, func(v interface{}) interface{} { return strings.ToLower(stringifyValue(v)) })
+	case domain.Trim:
+		return mapItems(value, func(v interface{}) interface{} { return strings.TrimSpace(stringifyValue(v)) })
+	case domain.ToString:
+		return mapItems(value, func(v interface{}) interface{} { return stringifyValue(v) })
+	case domain.URLEncode:
+		return mapItems(value, func(v interface{}) interface{} { return url.QueryEscape(stringifyValue(v)) })
+	case domain.ToInt:
+		return mapItems(value, func(v interface{}) interface{} { return applyToIntEncoder(log, v) })
+	case domain.SHA256:
+		return mapItems(value, func(v interface{}) interface{} {
+			sum := sha256.Sum256([]byte(stringifyValue(v)))
+			return hex.EncodeToString(sum[:])
+		})
+	case domain.HMACSHA256:
+		secret := fn.Argument(domain.HMACSHA256ArgSecret).Value
+		if !isPrimitiveValue(secret) {
+			log.Warn("hmac-sha256 encoder used without a secret, parameter ignored")
+			return nil
+		}
+
+		key := []byte(stringifyValue(secret))
+		return mapItems(value, func(v interface{}) interface{} {
+			mac := hmac.New(sha256.New, key)
+			mac.Write([]byte(stringifyValue(v)))
+			return hex.EncodeToString(mac.Sum(nil))
+		})
+	case domain.PluginFunction:
+		result, err := fn.Apply(log, value)
+		if err != nil {
+			log.Warn("failed to apply function plugin, parameter ignored", "error", err.Error(), "function", fn.Plugin.Name())
+			return nil
+		}
+
+		return result
+	default:
+		return value
+	}
+}
+
+func applyJoinEncoder(value interface{}, separator string) interface{} {
+	list, ok := value.([]interface{})
+	if !ok {
+		return stringifyValue(value)
+	}
+
+	for _, item := range list {
+		if _, isList := item.([]interface{}); isList {
+			return mapItems(list, func(v interface{}) interface{} { return applyJoinEncoder(v, separator) })
+		}
+	}
+
+	items := make([]string, len(list))
+	for i, item := range list {
+		items[i] = stringifyValue(item)
+	}
+
+	return strings.Join(items, separator)
+}
+
+func applyToIntEncoder(log restql.Logger, value interface{}) interface{} {
+	switch value := value.(type) {
+	case int:
+		return value
+	case float64:
+		if value == math.Trunc(value) {
+			return int(value)
+		}
+	case string:
+		result, err := strconv.Atoi(strings.TrimSpace(value))
+		if err == nil {
+			return result
+		}
+	}
+
+	log.Debug("failed to apply to-int encoder", "target", value)
+	return value
+}
+
+func mapItems(value interface{}, fn func(v interface{}) interface{}) interface{} {
+	list, ok := value.([]interface{})
+	if !ok {
+		if value == EmptyChained {
+			return value
+		}
+
+		return fn(value)
+	}
+
+	result := make([]interface{}, len(list))
+	for i, item := range list {
+		result[i] = mapItems(item, fn)
+	}
+
+	return result
+}
+
+func stringifyArgument(arg domain.Arg) string {
+	if arg.Value == nil {
+		return ""
+	}
+
+	return stringifyValue(arg.Value)
+}
+
+func stringifyValue(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case map[string]interface{}, []interface{}:
+		data, _ := json.Marshal(value)
+		return string(data)
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
@@ -1,6 +1,7 @@
 package runner_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
@@ -245,6 +246,100 @@ func TestApplyEncoders(t *testing.T) {
 				}},
 			}},
 		},
+		{
+			"should apply string functions to with values",
+			domain.Resources{"hero": domain.Statement{
+				Method:   "from",
+				Resource: "hero",
+				With: domain.Params{Values: map[string]interface{}{
+					"upper":  domain.Upper{Value: "batman"},
+					"lower":  domain.Lower{Value: []interface{}{"Batman", "Robin"}},
+					"trim":   domain.Trim{Value: "  batman "},
+					"string": domain.ToString{Value: 10},
+					"int":    domain.ToInt{Value: []interface{}{"10", float64(20), "invalid"}},
+					"url":    domain.URLEncode{Value: "bat man&robin"},
+					"sha":    domain.SHA256{Value: "batman"},
+					"hmac":   domain.NewHMACSHA256("id=1", "secret"),
+				}},
+			}},
+			domain.Resources{"hero": domain.Statement{
+				Method:   "from",
+				Resource: "hero",
+				With: domain.Params{Values: map[string]interface{}{
+					"upper":  "BATMAN",
+					"lower":  []interface{}{"batman", "robin"},
+					"trim":   "batman",
+					"string": "10",
+					"int":    []interface{}{10, 20, "invalid"},
+					"url":    "bat+man%26robin",
+					"sha":    "1532e76dbe9d43d0dea98c331ca5ae8a65c5e8e8b99d3e2a42ae989356f6242a",
+					"hmac":   "80e625b77e3ff9871f6f2d1ab6426c30e820bf18276a862d02633960f44b7cdf",
+				}},
+			}},
+		},
+		{
+			"should apply composed join and split functions to with values",
+			domain.Resources{"hero": domain.Statement{
+				Method:   "from",
+				Resource: "hero",
+				With: domain.Params{Values: map[string]interface{}{
+					"ids":   domain.NewJoin(domain.Upper{Value: []interface{}{"a", "b", "c"}}, ";"),
+					"names": domain.Trim{Value: domain.NewSplit("batman, robin", ",")},
+					"tags":  domain.NewJoin(domain.NoMultiplex{Value: []interface{}{"x", "y"}}, "-"),
+				}},
+			}},
+			domain.Resources{"hero": domain.Statement{
+				Method:   "from",
+				Resource: "hero",
+				With: domain.Params{Values: map[string]interface{}{
+					"ids":   "A;B;C",
+					"names": []interface{}{"batman", "robin"},
+					"tags":  domain.NoMultiplex{Value: "x-y"},
+				}},
+			}},
+		},
+		{
+			"should not apply string functions to empty chained values or without secret",
+			domain.Resources{"hero": domain.Statement{
+				Method:   "from",
+				Resource: "hero",
+				With: domain.Params{Values: map[string]interface{}{
+					"id":   domain.Lower{Value: runner.EmptyChained},
+					"ids":  domain.SHA256{Value: []interface{}{runner.EmptyChained, "batman"}},
+					"hmac": domain.NewHMACSHA256("id=1", domain.Variable{Target: "secret"}),
+				}},
+			}},
+			domain.Resources{"hero": domain.Statement{
+				Method:   "from",
+				Resource: "hero",
+				With: domain.Params{Values: map[string]interface{}{
+					"id":   runner.EmptyChained,
+					"ids":  []interface{}{runner.EmptyChained, "1532e76dbe9d43d0dea98c331ca5ae8a65c5e8e8b99d3e2a42ae989356f6242a"},
+					"hmac": nil,
+				}},
+			}},
+		},
+		{
+			"should apply function plugins to with values",
+			domain.Resources{"hero": domain.Statement{
+				Method:   "from",
+				Resource: "hero",
+				With: domain.Params{Values: map[string]interface{}{
+					"name":    domain.NewPluginFunction("batman", suffixFunction("suffix"), []interface{}{"!"}),
+					"id":      domain.NewPluginFunction(domain.Chain{"done-resource", "id"}, suffixFunction("suffix"), []interface{}{"!"}),
+					"invalid": domain.NewPluginFunction(10, suffixFunction("suffix"), []interface{}{"!"}),
+				}},
+			}},
+			domain.Resources{"hero": domain.Statement{
+				Method:   "from",
+				Resource: "hero",
+				With: domain.Params{Values: map[string]interface{}{
+					"name":    "batman!",
+					"id":      domain.NewPluginFunction(domain.Chain{"done-resource", "id"}, suffixFunction("suffix"), []interface{}{"!"}),
+					"invalid": nil,
+				}},
+			}},
+		},
 	}
 
 	logger := noOpLogger{}
@@ -265,3 +360,23 @@ func (n noOpLogger) Warn(msg string, fields ...interface{})             {}
 func (n noOpLogger) Info(msg string, fields ...interface{})             {}
 func (n noOpLogger) Debug(msg string, fields ...interface{})            {}
 func (n noOpLogger) With(key string, value interface{}) restql.Logger   { return n }
+
+type suffixFunction string
+
+func (s suffixFunction) Name() string {
+	return string(s)
+}
+
+func (s suffixFunction) Type() restql.PluginType {
+	return restql.FunctionPluginType
+}
+
+func (s suffixFunction) Apply(log restql.Logger, value interface{}, args restql.FunctionArguments) (interface{}, error) {
+	str, ok := value.(string)
+	if !ok {
+		return nil, errors.New("value is not a string")
+	}
+
+	suffix, _ := args.String(0)
+	return str + suffix, nil
+}
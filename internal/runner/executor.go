@@ -6,25 +6,63 @@ import (
 
 	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
 	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+	"golang.org/x/sync/singleflight"
 )
 
+// ExecutorOptions wraps all configuration parameters for the Executor.
+// When Coalescing is enabled identical in-flight GET requests share
+// a single upstream call, except for the resources whose mapping has
+// the no-coalescing option.
+type ExecutorOptions struct {
+	ResourceTimeout  time.Duration
+	ForwardPrefix    string
+	RetryStatusCodes []int
+	Coalescing       bool
+}
+
 // Executor process statements into a result
 // by executing the relevant HTTP calls to
 // the upstream dependency.
 type Executor struct {
-	client          domain.HTTPClient
-	log             restql.Logger
-	resourceTimeout time.Duration
-	forwardPrefix   string
+	client           domain.HTTPClient
+	log              restql.Logger
+	resourceTimeout  time.Duration
+	forwardPrefix    string
+	retryStatusCodes []int
+	coalescer        *singleflight.Group
 }
 
 // NewExecutor constructs an instance of Executor.
-func NewExecutor(log restql.Logger, client domain.HTTPClient, resourceTimeout time.Duration, forwardPrefix string) Executor {
-	return Executor{client: client, log: log, resourceTimeout: resourceTimeout, forwardPrefix: forwardPrefix}
+func NewExecutor(log restql.Logger, client domain.HTTPClient, options ExecutorOptions) Executor {
+	e := Executor{
+		client:           client,
+		log:              log,
+		resourceTimeout:  options.ResourceTimeout,
+		forwardPrefix:    options.ForwardPrefix,
+		retryStatusCodes: options.RetryStatusCodes,
+	}
+
+	if options.Coalescing {
+		e.coalescer = &singleflight.Group{}
+	}
+
+	return e
 }
 
 // DoStatement process a single statement into a result by executing the relevant HTTP calls to the upstream dependency.
 func (e Executor) DoStatement(ctx context.Context, statement domain.Statement, queryCtx restql.QueryContext) restql.DoneResource {
+	dr := e.doStatement(ctx, statement, queryCtx)
+
+	if statement.OnError != nil && !dr.Success && !dr.Skipped {
+		log := restql.GetLogger(ctx)
+		log.Debug("statement result replaced by fallback value", "resource", statement.Resource, "method", statement.Method, "status", dr.Status)
+		return NewFallbackResponse(log, dr, statement.OnError)
+	}
+
+	return dr
+}
+
+func (e Executor) doStatement(ctx context.Context, statement domain.Statement, queryCtx restql.QueryContext) restql.DoneResource {
 	log := restql.GetLogger(ctx)
 
 	drOptions := DoneResourceOptions{
@@ -39,6 +77,11 @@ func (e Executor) DoStatement(ctx context.Context, statement domain.Statement, q
 		return failedDependsOnResponse
 	}
 
+	if !IsConditionMet(statement.When) {
+		log.Debug("request execution skipped due to unmet condition", "resource", statement.Resource, "method", statement.Method)
+		return NewSkippedResponse(log, unmetConditionReason, drOptions)
+	}
+
 	emptyChainedParams := GetEmptyChainedParams(statement)
 	if len(emptyChainedParams) > 0 {
 		emptyChainedResponse := NewEmptyChainedResponse(log, emptyChainedParams, drOptions)
@@ -46,18 +89,37 @@ func (e Executor) DoStatement(ctx context.Context, statement domain.Statement, q
 		return emptyChainedResponse
 	}
 
+	if statement.Paginate != nil {
+		return e.doPaginatedStatement(ctx, statement, queryCtx, drOptions)
+	}
+
+	return e.doRequest(ctx, statement, queryCtx, drOptions)
+}
+
+func (e Executor) doRequest(ctx context.Context, statement domain.Statement, queryCtx restql.QueryContext, drOptions DoneResourceOptions) restql.DoneResource {
+	log := restql.GetLogger(ctx)
+
 	request := MakeRequest(e.resourceTimeout, e.forwardPrefix, statement, queryCtx)
 
 	log.Debug("executing request for statement", "resource", statement.Resource, "method", statement.Method, "request", request)
 
-	response, err := e.client.Do(ctx, request)
+	response, coalesced, err := e.doCoalesced(ctx, statement, request, queryCtx)
+	if statement.Retry != nil {
+		request, response, err = e.retryRequest(ctx, statement.Retry, request, response, err)
+		coalesced = coalesced && request.RetryAttempt == 0
+	}
+
 	if err != nil {
 		errorResponse := NewErrorResponse(log, err, request, response, drOptions)
+		errorResponse.Retries = request.RetryAttempt
+		errorResponse.Coalesced = coalesced
 		log.Debug("request execution failed", "error", err, "resource", statement.Resource, "method", statement.Method, "response", errorResponse)
 		return errorResponse
 	}
 
 	dr := NewDoneResource(request, response, drOptions)
+	dr.Retries = request.RetryAttempt
+	dr.Coalesced = coalesced
 
 	log.Debug("request execution done", "resource", statement.Resource, "method", statement.Method, "response", dr)
 
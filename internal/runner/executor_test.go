@@ -0,0 +1,221 @@
+package runner_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/internal/runner"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+	"github.com/b2wdigital/restQL-golang/v6/test"
+)
+
+func TestExecutorOnError(t *testing.T) {
+	queryCtx := restql.QueryContext{Mappings: map[string]restql.Mapping{"hero": mapping(t, "http://hero.io/api")}}
+	options := runner.ExecutorOptions{ResourceTimeout: time.Second}
+
+	respondWith := func(status int, body string) stubHTTPClient {
+		return func(request restql.HTTPRequest) (restql.HTTPResponse, error) {
+			return restql.HTTPResponse{StatusCode: status, Body: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(body))}, nil
+		}
+	}
+
+	fallback := &domain.OnError{Default: map[string]interface{}{"items": []interface{}{}}}
+
+	t.Run("should return fallback value when request fails", func(t *testing.T) {
+		statement := domain.Statement{Method: "from", Resource: "hero", DependsOn: domain.DependsOn{Resolved: true}, OnError: fallback}
+
+		got := runner.NewExecutor(test.NoOpLogger, respondWith(503, `{"error": "unavailable"}`), options).DoStatement(context.Background(), statement, queryCtx)
+
+		test.Equal(t, got.Status, 503)
+		test.Equal(t, got.Fallback, true)
+		test.Equal(t, got.ResponseBody.Unmarshal(), map[string]interface{}{"items": []interface{}{}})
+	})
+
+	t.Run("should return fallback value when dependency is unresolved", func(t *testing.T) {
+		statement := domain.Statement{Method: "from", Resource: "hero", DependsOn: domain.DependsOn{Targets: []string{"villain"}}, OnError: fallback}
+
+		got := runner.NewExecutor(test.NoOpLogger, respondWith(200, `{}`), options).DoStatement(context.Background(), statement, queryCtx)
+
+		test.Equal(t, got.Status, 400)
+		test.Equal(t, got.Fallback, true)
+		test.Equal(t, got.ResponseBody.Unmarshal(), map[string]interface{}{"items": []interface{}{}})
+	})
+
+	t.Run("should skip statement with unmet condition", func(t *testing.T) {
+		statement := domain.Statement{Method: "from", Resource: "hero", DependsOn: domain.DependsOn{Resolved: true}, When: &domain.Condition{Left: false}, OnError: fallback}
+
+		got := runner.NewExecutor(test.NoOpLogger, respondWith(200, `{}`), options).DoStatement(context.Background(), statement, queryCtx)
+
+		test.Equal(t, got.Skipped, true)
+		test.Equal(t, got.Fallback, false)
+		test.Equal(t, got.Status, 204)
+	})
+
+	t.Run("should keep upstream response when request succeeds", func(t *testing.T) {
+		statement := domain.Statement{Method: "from", Resource: "hero", DependsOn: domain.DependsOn{Resolved: true}, OnError: fallback}
+
+		got := runner.NewExecutor(test.NoOpLogger, respondWith(200, `{"items": [1]}`), options).DoStatement(context.Background(), statement, queryCtx)
+
+		test.Equal(t, got.Fallback, false)
+		test.Equal(t, got.ResponseBody.Unmarshal(), test.Unmarshal(`{"items": [1]}`))
+	})
+}
+
+func TestExecutorCoalescing(t *testing.T) {
+	queryCtx := restql.QueryContext{Mappings: map[string]restql.Mapping{
+		"config": mapping(t, "http://config.io/api"),
+		"user":   mapping(t, "http://user.io/api"),
+		"token":  mapping(t, "http://token.io/api#no-coalescing"),
+	}}
+
+	blockingClient := func(calls *int32, started chan struct{}, release chan struct{}) stubHTTPClient {
+		return func(request restql.HTTPRequest) (restql.HTTPResponse, error) {
+			atomic.AddInt32(calls, 1)
+			started <- struct{}{}
+			<-release
+
+			return restql.HTTPResponse{StatusCode: 200, Headers: restql.Headers{"X-Version": "1"}, Body: restql.NewResponseBodyFromBytes(test.NoOpLogger, []byte(`{"flag": true}`))}, nil
+		}
+	}
+
+	doConcurrently := func(executor runner.Executor, statement domain.Statement, n int) []restql.DoneResource {
+		results := make([]restql.DoneResource, n)
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i] = executor.DoStatement(context.Background(), statement, queryCtx)
+			}(i)
+		}
+		wg.Wait()
+
+		return results
+	}
+
+	t.Run("should share the upstream call among identical in-flight requests", func(t *testing.T) {
+		var calls int32
+		started, release := make(chan struct{}, 3), make(chan struct{})
+		executor := runner.NewExecutor(test.NoOpLogger, blockingClient(&calls, started, release), runner.ExecutorOptions{ResourceTimeout: time.Second, Coalescing: true})
+
+		go func() {
+			<-started
+			time.Sleep(50 * time.Millisecond)
+			close(release)
+		}()
+
+		statement := domain.Statement{Method: "from", Resource: "config", DependsOn: domain.DependsOn{Resolved: true}}
+		results := doConcurrently(executor, statement, 3)
+
+		test.Equal(t, atomic.LoadInt32(&calls), int32(1))
+		for _, dr := range results {
+			test.Equal(t, dr.Coalesced, true)
+			test.Equal(t, dr.ResponseBody.Unmarshal(), map[string]interface{}{"flag": true})
+		}
+
+		results[0].ResponseBody.SetValue("changed")
+		results[0].ResponseHeaders["X-Version"] = "2"
+		test.Equal(t, results[1].ResponseBody.Unmarshal(), map[string]interface{}{"flag": true})
+		test.Equal(t, results[1].ResponseHeaders["X-Version"], "1")
+	})
+
+	t.Run("should not cancel the shared call when the request starting it gives up", func(t *testing.T) {
+		started, release := make(chan struct{}, 1), make(chan struct{})
+		client := stubHTTPClientWithContext(func(ctx context.Context, request restql.HTTPRequest) (restql.HTTPResponse, error) {
+			started <- struct{}{}
+			<-release
+			if err := ctx.Err(); err != nil {
+				return restql.HTTPResponse{}, err
+			}
+
+			return restql.HTTPResponse{StatusCode: 200, Body: restql.NewResponseBodyFromBytes(test.NoOpLogger, []byte(`{"flag": true}`))}, nil
+		})
+		executor := runner.NewExecutor(test.NoOpLogger, client, runner.ExecutorOptions{ResourceTimeout: time.Second, Coalescing: true})
+		statement := domain.Statement{Method: "from", Resource: "config", DependsOn: domain.DependsOn{Resolved: true}}
+
+		firstCtx, cancel := context.WithCancel(context.Background())
+		first := make(chan restql.DoneResource)
+		go func() { first <- executor.DoStatement(firstCtx, statement, queryCtx) }()
+		<-started
+
+		second := make(chan restql.DoneResource)
+		go func() { second <- executor.DoStatement(context.Background(), statement, queryCtx) }()
+		time.Sleep(50 * time.Millisecond)
+
+		cancel()
+		<-first
+		close(release)
+
+		got := <-second
+		test.Equal(t, got.Status, 200)
+		test.Equal(t, got.Coalesced, true)
+		test.Equal(t, got.ResponseBody.Unmarshal(), map[string]interface{}{"flag": true})
+	})
+
+	t.Run("should not share calls with different timeouts", func(t *testing.T) {
+		var calls int32
+		started, release := make(chan struct{}, 2), make(chan struct{})
+		executor := runner.NewExecutor(test.NoOpLogger, blockingClient(&calls, started, release), runner.ExecutorOptions{ResourceTimeout: time.Second, Coalescing: true})
+
+		go func() {
+			for i := 0; i < 2; i++ {
+				<-started
+			}
+			close(release)
+		}()
+
+		statement := domain.Statement{Method: "from", Resource: "config", DependsOn: domain.DependsOn{Resolved: true}}
+		withTimeout := statement
+		withTimeout.Timeout = 500
+
+		var wg sync.WaitGroup
+		for _, stmt := range []domain.Statement{statement, withTimeout} {
+			wg.Add(1)
+			go func(stmt domain.Statement) {
+				defer wg.Done()
+				executor.DoStatement(context.Background(), stmt, queryCtx)
+			}(stmt)
+		}
+		wg.Wait()
+
+		test.Equal(t, atomic.LoadInt32(&calls), int32(2))
+	})
+
+	t.Run("should not share calls of resources opting out nor when disabled", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			resource string
+			options  runner.ExecutorOptions
+		}{
+			{"mapping with no-coalescing option", "token", runner.ExecutorOptions{ResourceTimeout: time.Second, Coalescing: true}},
+			{"coalescing disabled", "user", runner.ExecutorOptions{ResourceTimeout: time.Second}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var calls int32
+				started, release := make(chan struct{}, 3), make(chan struct{})
+				executor := runner.NewExecutor(test.NoOpLogger, blockingClient(&calls, started, release), tt.options)
+
+				go func() {
+					for i := 0; i < 3; i++ {
+						<-started
+					}
+					close(release)
+				}()
+
+				statement := domain.Statement{Method: "from", Resource: tt.resource, DependsOn: domain.DependsOn{Resolved: true}}
+				results := doConcurrently(executor, statement, 3)
+
+				test.Equal(t, atomic.LoadInt32(&calls), int32(3))
+				for _, dr := range results {
+					test.Equal(t, dr.Coalesced, false)
+				}
+			})
+		}
+	})
+}
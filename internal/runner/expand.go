@@ -1,7 +1,10 @@
 package runner
 
 import (
+	"fmt"
 	"math"
+	"sort"
+	"strings"
 
 	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
 )
@@ -21,13 +24,18 @@ type listParameters struct {
 	paramType string
 	path      []string
 	value     interface{}
+	cross     bool
 }
 
 // MultiplexStatements creates a statement for each value in a
 // list parameter value.
-// In case of multiple list parameter values it makes the cartesian
-// product of all the lists and makes a statement for each value in a
-// result product.
+// In case of multiple list parameter values it pairs the items
+// of all the lists by index and makes a statement for each pair,
+// limited by the shortest list.
+// List parameters with the cross function, or every list parameter
+// when the statement uses the cross multiplexing mode, are instead
+// multiplexed as a cartesian product, one parameter at a time in the
+// order of their names, nesting the resulting statements.
 func MultiplexStatements(resources domain.Resources) domain.Resources {
 	for resourceID, stmt := range resources {
 		switch stmt := stmt.(type) {
@@ -54,7 +62,11 @@ func multiplex(statement domain.Statement) interface{} {
 
 	listParams = append(listParams, bodyParams...)
 	if len(listParams) == 0 {
-		return statement
+		return batch(statement)
+	}
+
+	if lp, found := findCrossListParam(statement, listParams); found {
+		listParams = []listParameters{lp}
 	}
 
 	statementsParameters := zipListParams(listParams)
@@ -78,6 +90,189 @@ func multiplex(statement domain.Statement) interface{} {
 	return result
 }
 
+// ValidateFanOut checks that no statement was multiplexed into
+// more requests than the limit, which easily happens with the
+// cartesian product of list parameters.
+// A limit equal or lower than zero disables the check.
+func ValidateFanOut(resources domain.Resources, limit int) error {
+	if limit <= 0 {
+		return nil
+	}
+
+	for _, id := range sortedResourceIDs(resources) {
+		fanOut := len(flattenMultiplexed(resources[domain.ResourceID(id)]))
+		if fanOut > limit {
+			return fmt.Errorf("%w: statement %s would make %d requests, the limit is %d", ErrMaxFanOutExceeded, id, fanOut, limit)
+		}
+	}
+
+	return nil
+}
+
+// ValidateExpectedFanOut checks, before the statements are multiplexed,
+// that none of them would be expanded into more requests than the limit,
+// so a runaway cartesian product fails without being built.
+// The expected fan-out of a statement is the product of the lengths of
+// its cross multiplexed list parameters, the length of the shortest of
+// the other list parameters and the number of batches. Lists nested in
+// list items are only accounted for by ValidateFanOut, after expansion.
+// A limit equal or lower than zero disables the check.
+func ValidateExpectedFanOut(resources domain.Resources, limit int) error {
+	if limit <= 0 {
+		return nil
+	}
+
+	for _, id := range sortedResourceIDs(resources) {
+		stmt, ok := resources[domain.ResourceID(id)].(domain.Statement)
+		if !ok {
+			continue
+		}
+
+		fanOut := expectedFanOut(stmt)
+		if fanOut > uint64(limit) {
+			return fmt.Errorf("%w: statement %s would make %d requests, the limit is %d", ErrMaxFanOutExceeded, id, fanOut, limit)
+		}
+	}
+
+	return nil
+}
+
+func expectedFanOut(statement domain.Statement) uint64 {
+	if statement.With.Values == nil && statement.With.Body == nil {
+		return 1
+	}
+
+	listParams := getListParamsFromValues(statement.With.Values)
+	listParams = append(listParams, getListParamsFromBody(statement.With.Body)...)
+
+	var fanOut uint64 = 1
+	var zipped []listParameters
+	for _, lp := range listParams {
+		if lp.cross || statement.CrossMultiplex {
+			fanOut = multiplyFanOut(fanOut, valueLength(lp))
+		} else {
+			zipped = append(zipped, lp)
+		}
+	}
+
+	if len(zipped) > 0 {
+		fanOut = multiplyFanOut(fanOut, minimumListParamLength(zipped))
+	}
+
+	if batchParams := getBatchParamsFromValues(statement.With.Values); len(batchParams) > 0 {
+		fanOut = multiplyFanOut(fanOut, minimumListParamLength(batchParams))
+	}
+
+	return fanOut
+}
+
+func multiplyFanOut(a, b uint64) uint64 {
+	if a != 0 && b > math.MaxUint64/a {
+		return math.MaxUint64
+	}
+
+	return a * b
+}
+
+func findCrossListParam(statement domain.Statement, listParams []listParameters) (listParameters, bool) {
+	var crossParams []listParameters
+	for _, lp := range listParams {
+		if lp.cross || statement.CrossMultiplex {
+			crossParams = append(crossParams, lp)
+		}
+	}
+
+	if len(crossParams) == 0 {
+		return listParameters{}, false
+	}
+
+	sort.Slice(crossParams, func(i, j int) bool {
+		return strings.Join(crossParams[i].path, ".") < strings.Join(crossParams[j].path, ".")
+	})
+
+	return crossParams[0], true
+}
+
+// batch creates a statement for each group of the list parameters
+// with the batch function applied, the groups of different parameters
+// being zipped as in the regular multiplexing.
+// It is applied after the regular multiplexing, hence every statement
+// created from the same list is on the same level of the result.
+func batch(statement domain.Statement) interface{} {
+	batchParams := getBatchParamsFromValues(statement.With.Values)
+	if len(batchParams) == 0 {
+		return statement
+	}
+
+	statementsParameters := zipListParams(batchParams)
+
+	result := make([]interface{}, len(statementsParameters))
+	for i, parameters := range statementsParameters {
+		newStmt := copyStatement(statement)
+		newStmt.Batched = true
+		for _, p := range parameters {
+			setParameterOnStatement(newStmt.With.Values, p.path, p.value)
+		}
+
+		result[i] = newStmt
+	}
+
+	return result
+}
+
+func getBatchParamsFromValues(values map[string]interface{}) []listParameters {
+	var result []listParameters
+	for key, val := range values {
+		lp := findBatchParameters([]string{key}, val)
+		result = append(result, lp...)
+	}
+
+	return result
+}
+
+func findBatchParameters(path []string, val interface{}) []listParameters {
+	switch val := val.(type) {
+	case domain.Batch:
+		list, ok := val.Target().([]interface{})
+		if !ok {
+			return []listParameters{}
+		}
+
+		size := getBatchSize(val, len(list))
+		var groups []interface{}
+		for start := 0; start < len(list); start += size {
+			end := start + size
+			if end > len(list) {
+				end = len(list)
+			}
+
+			groups = append(groups, domain.NoMultiplex{Value: list[start:end]})
+		}
+
+		return []listParameters{{path: path, paramType: valuesParamType, value: groups}}
+	case map[string]interface{}:
+		var result []listParameters
+		for k, v := range val {
+			lp := findBatchParameters(append(path, k), v)
+			result = append(result, lp...)
+		}
+		return result
+	default:
+		return []listParameters{}
+	}
+}
+
+// getBatchSize returns the size argument of the batch function,
+// using the whole list as a single group when it is not valid.
+func getBatchSize(fn domain.Batch, listLength int) int {
+	size, ok := fn.Argument(domain.BatchArgSize).Value.(int)
+	if !ok || size <= 0 {
+		return listLength
+	}
+
+	return size
+}
+
 func getListParamsFromBody(body interface{}) []listParameters {
 	var result []listParameters
 	if body, ok := body.([]interface{}); ok {
@@ -117,6 +312,13 @@ func findListParameters(path []string, val interface{}) []listParameters {
 	switch val := val.(type) {
 	case domain.NoMultiplex:
 		return []listParameters{}
+	case domain.Cross:
+		parameters := findListParameters(path, val.Target())
+		for i := range parameters {
+			parameters[i].cross = true
+		}
+
+		return parameters
 	case domain.AsQuery:
 		parameters := findListParameters(path, val.Target())
 		if len(parameters) == 0 {
@@ -230,6 +432,12 @@ func copyValue(value interface{}) interface{} {
 			m[k] = copyValue(v)
 		}
 		return m
+	case []interface{}:
+		l := make([]interface{}, len(value))
+		for i, v := range value {
+			l[i] = copyValue(v)
+		}
+		return l
 	default:
 		return value
 	}
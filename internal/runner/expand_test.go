@@ -1,6 +1,7 @@
 package runner_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
@@ -307,6 +308,129 @@ func TestMultiplexStatements(t *testing.T) {
 				},
 			},
 		},
+		{
+			"should make a new statement for each value of the cartesian product of list params with cross function",
+			domain.Resources{
+				"quote": domain.Statement{
+					Method:   "from",
+					Resource: "quote",
+					With:     domain.Params{Values: map[string]interface{}{"seller": domain.Cross{Value: []interface{}{"a", "b"}}, "zip": domain.Cross{Value: []interface{}{"1", "2", "3"}}}},
+				},
+			},
+			domain.Resources{
+				"quote": []interface{}{
+					[]interface{}{
+						domain.Statement{Method: "from", Resource: "quote", With: domain.Params{Values: map[string]interface{}{"seller": "a", "zip": "1"}}},
+						domain.Statement{Method: "from", Resource: "quote", With: domain.Params{Values: map[string]interface{}{"seller": "a", "zip": "2"}}},
+						domain.Statement{Method: "from", Resource: "quote", With: domain.Params{Values: map[string]interface{}{"seller": "a", "zip": "3"}}},
+					},
+					[]interface{}{
+						domain.Statement{Method: "from", Resource: "quote", With: domain.Params{Values: map[string]interface{}{"seller": "b", "zip": "1"}}},
+						domain.Statement{Method: "from", Resource: "quote", With: domain.Params{Values: map[string]interface{}{"seller": "b", "zip": "2"}}},
+						domain.Statement{Method: "from", Resource: "quote", With: domain.Params{Values: map[string]interface{}{"seller": "b", "zip": "3"}}},
+					},
+				},
+			},
+		},
+		{
+			"should cross list param with cross function against the pairs of the other list params",
+			domain.Resources{
+				"quote": domain.Statement{
+					Method:   "from",
+					Resource: "quote",
+					With:     domain.Params{Values: map[string]interface{}{"zip": domain.Cross{Value: []interface{}{"1", "2"}}, "seller": []interface{}{"a", "b"}, "item": []interface{}{"x", "y", "z"}}},
+				},
+			},
+			domain.Resources{
+				"quote": []interface{}{
+					[]interface{}{
+						domain.Statement{Method: "from", Resource: "quote", With: domain.Params{Values: map[string]interface{}{"zip": "1", "seller": "a", "item": "x"}}},
+						domain.Statement{Method: "from", Resource: "quote", With: domain.Params{Values: map[string]interface{}{"zip": "1", "seller": "b", "item": "y"}}},
+					},
+					[]interface{}{
+						domain.Statement{Method: "from", Resource: "quote", With: domain.Params{Values: map[string]interface{}{"zip": "2", "seller": "a", "item": "x"}}},
+						domain.Statement{Method: "from", Resource: "quote", With: domain.Params{Values: map[string]interface{}{"zip": "2", "seller": "b", "item": "y"}}},
+					},
+				},
+			},
+		},
+		{
+			"should make a new statement for each value of the cartesian product of every list param on cross multiplex mode",
+			domain.Resources{
+				"quote": domain.Statement{
+					Method:         "from",
+					Resource:       "quote",
+					CrossMultiplex: true,
+					With:           domain.Params{Values: map[string]interface{}{"zip": []interface{}{"1", "2"}, "seller": []interface{}{"a", "b"}}},
+				},
+			},
+			domain.Resources{
+				"quote": []interface{}{
+					[]interface{}{
+						domain.Statement{Method: "from", Resource: "quote", CrossMultiplex: true, With: domain.Params{Values: map[string]interface{}{"seller": "a", "zip": "1"}}},
+						domain.Statement{Method: "from", Resource: "quote", CrossMultiplex: true, With: domain.Params{Values: map[string]interface{}{"seller": "a", "zip": "2"}}},
+					},
+					[]interface{}{
+						domain.Statement{Method: "from", Resource: "quote", CrossMultiplex: true, With: domain.Params{Values: map[string]interface{}{"seller": "b", "zip": "1"}}},
+						domain.Statement{Method: "from", Resource: "quote", CrossMultiplex: true, With: domain.Params{Values: map[string]interface{}{"seller": "b", "zip": "2"}}},
+					},
+				},
+			},
+		},
+		{
+			"should make a new statement for each group of list value with batch function",
+			domain.Resources{
+				"hero": domain.Statement{
+					Method:   "from",
+					Resource: "hero",
+					With:     domain.Params{Values: map[string]interface{}{"id": domain.NewBatch([]interface{}{1, 2, 3, 4, 5}, 2), "name": "batman"}},
+				},
+			},
+			domain.Resources{
+				"hero": []interface{}{
+					domain.Statement{Method: "from", Resource: "hero", Batched: true, With: domain.Params{Values: map[string]interface{}{"id": domain.NoMultiplex{Value: []interface{}{1, 2}}, "name": "batman"}}},
+					domain.Statement{Method: "from", Resource: "hero", Batched: true, With: domain.Params{Values: map[string]interface{}{"id": domain.NoMultiplex{Value: []interface{}{3, 4}}, "name": "batman"}}},
+					domain.Statement{Method: "from", Resource: "hero", Batched: true, With: domain.Params{Values: map[string]interface{}{"id": domain.NoMultiplex{Value: []interface{}{5}}, "name": "batman"}}},
+				},
+			},
+		},
+		{
+			"should batch list value after multiplexing the other list params",
+			domain.Resources{
+				"hero": domain.Statement{
+					Method:   "from",
+					Resource: "hero",
+					With:     domain.Params{Values: map[string]interface{}{"id": domain.NewBatch([]interface{}{1, 2, 3}, 2), "name": []interface{}{"batman", "robin"}}},
+				},
+			},
+			domain.Resources{
+				"hero": []interface{}{
+					[]interface{}{
+						domain.Statement{Method: "from", Resource: "hero", Batched: true, With: domain.Params{Values: map[string]interface{}{"id": domain.NoMultiplex{Value: []interface{}{1, 2}}, "name": "batman"}}},
+						domain.Statement{Method: "from", Resource: "hero", Batched: true, With: domain.Params{Values: map[string]interface{}{"id": domain.NoMultiplex{Value: []interface{}{3}}, "name": "batman"}}},
+					},
+					[]interface{}{
+						domain.Statement{Method: "from", Resource: "hero", Batched: true, With: domain.Params{Values: map[string]interface{}{"id": domain.NoMultiplex{Value: []interface{}{1, 2}}, "name": "robin"}}},
+						domain.Statement{Method: "from", Resource: "hero", Batched: true, With: domain.Params{Values: map[string]interface{}{"id": domain.NoMultiplex{Value: []interface{}{3}}, "name": "robin"}}},
+					},
+				},
+			},
+		},
+		{
+			"should keep the whole list in a single group when batch size is invalid",
+			domain.Resources{
+				"hero": domain.Statement{
+					Method:   "from",
+					Resource: "hero",
+					With:     domain.Params{Values: map[string]interface{}{"id": domain.NewBatch([]interface{}{1, 2, 3}, "ten")}},
+				},
+			},
+			domain.Resources{
+				"hero": []interface{}{
+					domain.Statement{Method: "from", Resource: "hero", Batched: true, With: domain.Params{Values: map[string]interface{}{"id": domain.NoMultiplex{Value: []interface{}{1, 2, 3}}}}},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -316,3 +440,81 @@ func TestMultiplexStatements(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateFanOut(t *testing.T) {
+	resources := domain.Resources{
+		"hero": domain.Statement{Method: "from", Resource: "hero"},
+		"quote": []interface{}{
+			[]interface{}{domain.Statement{Method: "from", Resource: "quote"}, domain.Statement{Method: "from", Resource: "quote"}},
+			[]interface{}{domain.Statement{Method: "from", Resource: "quote"}, domain.Statement{Method: "from", Resource: "quote"}},
+		},
+	}
+
+	t.Run("should accept statements within the limit", func(t *testing.T) {
+		test.VerifyError(t, runner.ValidateFanOut(resources, 4))
+	})
+
+	t.Run("should accept any statement when limit is disabled", func(t *testing.T) {
+		test.VerifyError(t, runner.ValidateFanOut(resources, 0))
+	})
+
+	t.Run("should fail when statement exceeds the limit", func(t *testing.T) {
+		err := runner.ValidateFanOut(resources, 3)
+
+		test.Equal(t, errors.Is(err, runner.ErrMaxFanOutExceeded), true)
+		test.Equal(t, err.Error(), "max fan-out exceeded: statement execution denied: statement quote would make 4 requests, the limit is 3")
+	})
+}
+
+func TestValidateExpectedFanOut(t *testing.T) {
+	list := func(size int) []interface{} {
+		l := make([]interface{}, size)
+		for i := range l {
+			l[i] = i
+		}
+		return l
+	}
+
+	t.Run("should fail before expanding a cartesian product over the limit", func(t *testing.T) {
+		resources := domain.Resources{
+			"hero": domain.Statement{Method: "from", Resource: "hero", With: domain.Params{Values: map[string]interface{}{
+				"a": domain.Cross{Value: list(1000)},
+				"b": domain.Cross{Value: list(1000)},
+				"c": domain.Cross{Value: list(1000)},
+			}}},
+		}
+
+		err := runner.ValidateExpectedFanOut(resources, 1000)
+
+		test.Equal(t, errors.Is(err, runner.ErrMaxFanOutExceeded), true)
+		test.Equal(t, err.Error(), "max fan-out exceeded: statement execution denied: statement hero would make 1000000000 requests, the limit is 1000")
+	})
+
+	t.Run("should count zipped lists by the shortest one and batches by their groups", func(t *testing.T) {
+		resources := domain.Resources{
+			"hero": domain.Statement{Method: "from", Resource: "hero", With: domain.Params{Values: map[string]interface{}{
+				"id":   list(3),
+				"name": list(5),
+				"tag":  domain.Cross{Value: list(2)},
+				"page": domain.NewBatch(list(4), 2),
+			}}},
+		}
+
+		test.VerifyError(t, runner.ValidateExpectedFanOut(resources, 12))
+
+		err := runner.ValidateExpectedFanOut(resources, 11)
+		test.Equal(t, errors.Is(err, runner.ErrMaxFanOutExceeded), true)
+
+		expanded := runner.MultiplexStatements(resources)
+		test.VerifyError(t, runner.ValidateFanOut(expanded, 12))
+		test.Equal(t, errors.Is(runner.ValidateFanOut(expanded, 11), runner.ErrMaxFanOutExceeded), true)
+	})
+
+	t.Run("should accept any statement when limit is disabled", func(t *testing.T) {
+		resources := domain.Resources{
+			"hero": domain.Statement{Method: "from", Resource: "hero", CrossMultiplex: true, With: domain.Params{Values: map[string]interface{}{"a": list(1000), "b": list(1000)}}},
+		}
+
+		test.VerifyError(t, runner.ValidateExpectedFanOut(resources, 0))
+	})
+}
@@ -0,0 +1,241 @@
+package runner
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+	"github.com/pkg/errors"
+)
+
+// ErrUnresolvableDependencies represents an error when statements
+// depend on each other, hence can never be executed.
+var ErrUnresolvableDependencies = errors.New("statements have circular dependencies")
+
+// Plan describes how a query would be executed.
+// Waves are the groups of statements executed in parallel,
+// each one waiting for the previous to finish.
+type Plan struct {
+	Waves      [][]string
+	Statements map[string]PlannedStatement
+}
+
+// PlannedStatement describes the requests a statement would make.
+// FanOut is the number of requests known before the execution,
+// when Dynamic is true the final number also depends on the
+// values returned by the statements it is chained to.
+type PlannedStatement struct {
+	Wave      int
+	Method    string
+	Resource  string
+	DependsOn []string
+	FanOut    int
+	Dynamic   bool
+	URLs      []string
+}
+
+// Explain builds the execution Plan of a query using the
+// same dependency resolution of ExecuteQuery, but without
+// calling any upstream dependency.
+// Values chained to other statements are shown in the
+// URLs as placeholders in the form `{resource.path}`.
+func (r Runner) Explain(query domain.Query, queryCtx restql.QueryContext) (Plan, error) {
+	resources, err := r.initializeResources(query)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	err = ValidateFanOut(resources, r.options.MaxFanOut)
+	if err != nil {
+		return Plan{}, err
+	}
+
+	plan := Plan{Statements: make(map[string]PlannedStatement)}
+	for resourceID, resource := range resources {
+		plan.Statements[string(resourceID)] = r.planStatement(resource, queryCtx)
+	}
+
+	state := NewState(resources)
+	for !state.HasFinished() {
+		available := state.Available()
+		if len(available) == 0 {
+			return Plan{}, fmt.Errorf("%w: %s", ErrUnresolvableDependencies, strings.Join(sortedResourceIDs(state.todo), ", "))
+		}
+
+		wave := sortedResourceIDs(available)
+		for _, id := range wave {
+			state.SetAsRequest(domain.ResourceID(id))
+			state.UpdateDone(domain.ResourceID(id), nil)
+
+			ps := plan.Statements[id]
+			ps.Wave = len(plan.Waves) + 1
+			plan.Statements[id] = ps
+		}
+
+		plan.Waves = append(plan.Waves, wave)
+	}
+
+	return plan, nil
+}
+
+func (r Runner) planStatement(resource interface{}, queryCtx restql.QueryContext) PlannedStatement {
+	statements := flattenMultiplexed(resource)
+
+	ps := PlannedStatement{FanOut: len(statements)}
+	for _, stmt := range statements {
+		ps.Method = queryMethodToHTTPMethod[stmt.Method]
+		ps.Resource = stmt.Resource
+		ps.DependsOn = StatementDependencies(stmt)
+		ps.Dynamic = ps.Dynamic || stmt.Paginate != nil || hasMultiplexedChain(stmt.With.Values)
+
+		request := MakeRequest(0, r.executor.forwardPrefix, withChainPlaceholders(stmt), queryCtx)
+		ps.URLs = append(ps.URLs, requestURL(request))
+	}
+
+	return ps
+}
+
+func flattenMultiplexed(resource interface{}) []domain.Statement {
+	switch resource := resource.(type) {
+	case domain.Statement:
+		return []domain.Statement{resource}
+	case []interface{}:
+		var statements []domain.Statement
+		for _, r := range resource {
+			statements = append(statements, flattenMultiplexed(r)...)
+		}
+		return statements
+	default:
+		return nil
+	}
+}
+
+func hasMultiplexedChain(values map[string]interface{}) bool {
+	for _, v := range values {
+		if isMultiplexedChain(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isMultiplexedChain(value interface{}) bool {
+	switch value := value.(type) {
+	case domain.Chain:
+		return true
+	case domain.NoMultiplex:
+		return false
+	case domain.Function:
+		return isMultiplexedChain(value.Target())
+	case map[string]interface{}:
+		return hasMultiplexedChain(value)
+	case []interface{}:
+		for _, v := range value {
+			if isMultiplexedChain(v) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+func withChainPlaceholders(stmt domain.Statement) domain.Statement {
+	if stmt.Headers != nil {
+		headers := make(map[string]interface{}, len(stmt.Headers))
+		for k, v := range stmt.Headers {
+			headers[k] = chainPlaceholder(v)
+		}
+		stmt.Headers = headers
+	}
+
+	if stmt.With.Values == nil {
+		return stmt
+	}
+
+	values := make(map[string]interface{}, len(stmt.With.Values))
+	for k, v := range stmt.With.Values {
+		values[k] = chainPlaceholder(v)
+	}
+	stmt.With.Values = values
+
+	return unwrapStatement(stmt)
+}
+
+func chainPlaceholder(value interface{}) interface{} {
+	switch value := value.(type) {
+	case domain.Chain:
+		path := make([]string, len(value))
+		for i, p := range value {
+			path[i] = fmt.Sprintf("%v", p)
+		}
+		return "{" + strings.Join(path, ".") + "}"
+	case domain.Template:
+		var sb strings.Builder
+		for _, part := range value {
+			sb.WriteString(fmt.Sprintf("%v", chainPlaceholder(part)))
+		}
+		return sb.String()
+	case domain.Function:
+		if _, ok := value.Target().(domain.Chain); ok {
+			return chainPlaceholder(value.Target())
+		}
+		return value.Map(chainPlaceholder)
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			m[k] = chainPlaceholder(v)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(value))
+		for i, v := range value {
+			l[i] = chainPlaceholder(v)
+		}
+		return l
+	default:
+		return value
+	}
+}
+
+func requestURL(request restql.HTTPRequest) string {
+	url := request.Schema + "://" + request.Host + request.Path
+
+	keys := make([]string, 0, len(request.Query))
+	for k := range request.Query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var args []string
+	for _, k := range keys {
+		switch v := request.Query[k].(type) {
+		case []interface{}:
+			for _, item := range v {
+				args = append(args, fmt.Sprintf("%s=%v", k, item))
+			}
+		default:
+			args = append(args, fmt.Sprintf("%s=%v", k, v))
+		}
+	}
+
+	if len(args) > 0 {
+		url += "?" + strings.Join(args, "&")
+	}
+
+	return url
+}
+
+func sortedResourceIDs(resources domain.Resources) []string {
+	ids := make([]string, 0, len(resources))
+	for id := range resources {
+		ids = append(ids, string(id))
+	}
+	sort.Strings(ids)
+
+	return ids
+}
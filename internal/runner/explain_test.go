@@ -0,0 +1,91 @@
+package runner_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/internal/runner"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+	"github.com/b2wdigital/restQL-golang/v6/test"
+)
+
+func TestExplain(t *testing.T) {
+	client := stubHTTPClient(func(request restql.HTTPRequest) (restql.HTTPResponse, error) {
+		t.Fatalf("unexpected upstream call: %+v", request)
+		return restql.HTTPResponse{}, nil
+	})
+	r := runner.NewRunner(test.NoOpLogger, runner.NewExecutor(test.NoOpLogger, client, runner.ExecutorOptions{}), runner.Options{})
+
+	queryCtx := restql.QueryContext{Mappings: map[string]restql.Mapping{
+		"hero":     mapping(t, "http://hero.io/api/:id"),
+		"sidekick": mapping(t, "http://sidekick.io/api"),
+		"villain":  mapping(t, "http://villain.io/api"),
+	}}
+
+	t.Run("should group statements into waves with their requests", func(t *testing.T) {
+		query := domain.Query{Statements: []domain.Statement{
+			{Method: "from", Resource: "hero", With: domain.Params{Values: map[string]interface{}{"id": []interface{}{1, 2}}}},
+			{Method: "from", Resource: "sidekick", With: domain.Params{Values: map[string]interface{}{"heroId": domain.Chain{"hero", "id"}, "tags": domain.NoMultiplex{Value: []interface{}{"a", "b"}}}}},
+			{Method: "to", Resource: "villain", DependsOn: domain.DependsOn{Targets: []string{"sidekick"}}, Headers: map[string]interface{}{"Authorization": domain.Template{"Bearer ", domain.Chain{"hero", "token"}}}},
+		}}
+
+		expected := runner.Plan{
+			Waves: [][]string{{"hero"}, {"sidekick"}, {"villain"}},
+			Statements: map[string]runner.PlannedStatement{
+				"hero": {
+					Wave:      1,
+					Method:    "GET",
+					Resource:  "hero",
+					DependsOn: []string{},
+					FanOut:    2,
+					URLs:      []string{"http://hero.io/api/1", "http://hero.io/api/2"},
+				},
+				"sidekick": {
+					Wave:      2,
+					Method:    "GET",
+					Resource:  "sidekick",
+					DependsOn: []string{"hero"},
+					FanOut:    1,
+					Dynamic:   true,
+					URLs:      []string{"http://sidekick.io/api?heroId={hero.id}&tags=a&tags=b"},
+				},
+				"villain": {
+					Wave:      3,
+					Method:    "POST",
+					Resource:  "villain",
+					DependsOn: []string{"hero", "sidekick"},
+					FanOut:    1,
+					URLs:      []string{"http://villain.io/api"},
+				},
+			},
+		}
+
+		got, err := r.Explain(query, queryCtx)
+
+		test.VerifyError(t, err)
+		test.Equal(t, got, expected)
+	})
+
+	t.Run("should fail when statements depend on each other", func(t *testing.T) {
+		query := domain.Query{Statements: []domain.Statement{
+			{Method: "from", Resource: "hero", With: domain.Params{Values: map[string]interface{}{"id": domain.Chain{"sidekick", "heroId"}}}},
+			{Method: "from", Resource: "sidekick", DependsOn: domain.DependsOn{Targets: []string{"hero"}}},
+		}}
+
+		_, err := r.Explain(query, queryCtx)
+
+		test.Equal(t, errors.Is(err, runner.ErrUnresolvableDependencies), true)
+	})
+
+	t.Run("should fail when statement exceeds the fan-out limit", func(t *testing.T) {
+		limited := runner.NewRunner(test.NoOpLogger, runner.NewExecutor(test.NoOpLogger, client, runner.ExecutorOptions{}), runner.Options{MaxFanOut: 1})
+		query := domain.Query{Statements: []domain.Statement{
+			{Method: "from", Resource: "hero", With: domain.Params{Values: map[string]interface{}{"id": []interface{}{1, 2}}}},
+		}}
+
+		_, err := limited.Explain(query, queryCtx)
+
+		test.Equal(t, errors.Is(err, runner.ErrMaxFanOutExceeded), true)
+	})
+}
@@ -6,11 +6,12 @@ import (
 
 // ApplyModifiers transforms an unresolved Resources collection and
 // set the query level cache directives into each statement without
-// cache directives.
+// cache directives, as well as the query level multiplexing mode.
 func ApplyModifiers(resources domain.Resources, modifiers domain.Modifiers) domain.Resources {
 	for resourceID, stmt := range resources {
 		if stmt, ok := stmt.(domain.Statement); ok {
 			stmt.CacheControl = applyCacheModifiers(modifiers, stmt)
+			stmt.CrossMultiplex = modifiers["multiplex"] == "cross"
 			resources[resourceID] = stmt
 		}
 	}
@@ -105,6 +105,12 @@ func TestApplyModifiers(t *testing.T) {
 				},
 			},
 		},
+		{
+			"should apply cross multiplex modifier to statement",
+			domain.Modifiers{"multiplex": "cross"},
+			domain.Resources{"hero": domain.Statement{Resource: "hero"}},
+			domain.Resources{"hero": domain.Statement{Resource: "hero", CrossMultiplex: true}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,199 @@
+package runner
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+)
+
+const defaultMaxPages = 10
+
+// doPaginatedStatement executes the statement requests following
+// the cursor defined by the `paginate` clause, until there is no
+// next page or the page limit is reached.
+func (e Executor) doPaginatedStatement(ctx context.Context, statement domain.Statement, queryCtx restql.QueryContext, drOptions DoneResourceOptions) restql.DoneResource {
+	log := restql.GetLogger(ctx)
+	pagination := statement.Paginate
+	maxPages := getMaxPages(pagination)
+
+	var pages []restql.DoneResource
+	var previousCursor interface{}
+
+	stmt := statement
+	for {
+		dr := e.doRequest(ctx, stmt, queryCtx, drOptions)
+		pages = append(pages, dr)
+
+		if !dr.Success {
+			log.Debug("pagination interrupted due to failed page", "resource", statement.Resource, "page", len(pages))
+			return newFailedPageResponse(log, pages, pagination.Path)
+		}
+
+		if len(pages) >= maxPages || ctx.Err() != nil {
+			break
+		}
+
+		cursor, found := findCursor(dr, pagination.Path)
+		if !found || reflect.DeepEqual(cursor, previousCursor) {
+			break
+		}
+
+		previousCursor = cursor
+		stmt = setPaginationParam(statement, pagination.Param, cursor)
+	}
+
+	return MergePages(log, pages, pagination.Path)
+}
+
+func getMaxPages(pagination *domain.Pagination) int {
+	maxPages, ok := pagination.MaxPages.(int)
+	if !ok || maxPages <= 0 {
+		return defaultMaxPages
+	}
+
+	return maxPages
+}
+
+func findCursor(dr restql.DoneResource, path []string) (interface{}, bool) {
+	if dr.ResponseBody == nil {
+		return nil, false
+	}
+
+	cursor, found := getValueOnPath(dr.ResponseBody.Unmarshal(), path)
+	if !found || cursor == nil || cursor == "" {
+		return nil, false
+	}
+
+	return cursor, true
+}
+
+func getValueOnPath(value interface{}, path []string) (interface{}, bool) {
+	if len(path) == 0 {
+		return value, true
+	}
+
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	v, found := m[path[0]]
+	if !found {
+		return nil, false
+	}
+
+	return getValueOnPath(v, path[1:])
+}
+
+func setPaginationParam(statement domain.Statement, param string, cursor interface{}) domain.Statement {
+	stmt := copyStatement(statement)
+	if stmt.With.Values == nil {
+		stmt.With.Values = make(map[string]interface{})
+	}
+
+	stmt.With.Values[param] = cursor
+
+	return stmt
+}
+
+// newFailedPageResponse builds the result of a pagination interrupted
+// by a failed page. The successful pages are merged as usual, while
+// the failure is only reported on the page details. If the first
+// page fails, there is nothing to merge and its response is returned.
+func newFailedPageResponse(log restql.Logger, pages []restql.DoneResource, cursorPath []string) restql.DoneResource {
+	if len(pages) == 1 {
+		return pages[0]
+	}
+
+	result := MergePages(log, pages[:len(pages)-1], cursorPath)
+	result.Pages = pageDetails(pages)
+
+	return result
+}
+
+// MergePages builds a single result from the responses of
+// a paginated statement. Lists are concatenated and objects
+// are merged, with fields of later pages taking precedence.
+func MergePages(log restql.Logger, pages []restql.DoneResource, cursorPath []string) restql.DoneResource {
+	if len(pages) == 1 {
+		return pages[0]
+	}
+
+	var body interface{}
+	var responseTime int64
+	for _, page := range pages {
+		if page.ResponseBody != nil {
+			body = mergePageBody(body, page.ResponseBody.Unmarshal())
+		}
+		responseTime += page.ResponseTime
+	}
+
+	last := pages[len(pages)-1]
+	if _, found := findCursor(last, cursorPath); !found {
+		body = removeValueOnPath(body, cursorPath)
+	}
+
+	result := pages[0]
+	result.Status = last.Status
+	result.Success = last.Success
+	result.ResponseTime = responseTime
+	result.ResponseBody = restql.NewResponseBodyFromValue(log, body)
+	result.Pages = pageDetails(pages)
+
+	return result
+}
+
+func mergePageBody(acc interface{}, page interface{}) interface{} {
+	switch page := page.(type) {
+	case []interface{}:
+		accList, ok := acc.([]interface{})
+		if !ok {
+			return page
+		}
+
+		return append(accList, page...)
+	case map[string]interface{}:
+		accMap, ok := acc.(map[string]interface{})
+		if !ok {
+			return page
+		}
+
+		for key, value := range page {
+			accMap[key] = mergePageBody(accMap[key], value)
+		}
+
+		return accMap
+	default:
+		return page
+	}
+}
+
+func removeValueOnPath(value interface{}, path []string) interface{} {
+	m, ok := value.(map[string]interface{})
+	if !ok || len(path) == 0 {
+		return value
+	}
+
+	if len(path) == 1 {
+		delete(m, path[0])
+		return m
+	}
+
+	if next, found := m[path[0]]; found {
+		m[path[0]] = removeValueOnPath(next, path[1:])
+	}
+
+	return m
+}
+
+func pageDetails(pages []restql.DoneResource) []restql.DoneResource {
+	result := make([]restql.DoneResource, len(pages))
+	for i, page := range pages {
+		page.ResponseBody = nil
+		result[i] = page
+	}
+
+	return result
+}
@@ -0,0 +1,145 @@
+package runner_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/internal/runner"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+	"github.com/b2wdigital/restQL-golang/v6/test"
+)
+
+type stubHTTPClient func(request restql.HTTPRequest) (restql.HTTPResponse, error)
+
+func (s stubHTTPClient) Do(ctx context.Context, request restql.HTTPRequest) (restql.HTTPResponse, error) {
+	return s(request)
+}
+
+type stubHTTPClientWithContext func(ctx context.Context, request restql.HTTPRequest) (restql.HTTPResponse, error)
+
+func (s stubHTTPClientWithContext) Do(ctx context.Context, request restql.HTTPRequest) (restql.HTTPResponse, error) {
+	return s(ctx, request)
+}
+
+func TestPaginatedStatement(t *testing.T) {
+	queryCtx := restql.QueryContext{Mappings: map[string]restql.Mapping{"product": mapping(t, "http://product.io/api")}}
+
+	pagedClient := func(pages map[interface{}]string, requested *[]interface{}) stubHTTPClient {
+		return func(request restql.HTTPRequest) (restql.HTTPResponse, error) {
+			cursor := request.Query["cursor"]
+			*requested = append(*requested, cursor)
+
+			body, found := pages[cursor]
+			if !found {
+				return restql.HTTPResponse{StatusCode: 500}, errors.New("unexpected page")
+			}
+
+			return restql.HTTPResponse{
+				URL:        fmt.Sprintf("http://product.io/api?cursor=%v", cursor),
+				StatusCode: 200,
+				Body:       restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(body)),
+				Duration:   10 * time.Millisecond,
+			}, nil
+		}
+	}
+
+	t.Run("should request every page and concatenate the results", func(t *testing.T) {
+		var requested []interface{}
+		client := pagedClient(map[interface{}]string{
+			nil: `{"result": {"items": [1, 2], "nextCursor": "b"}, "total": 5}`,
+			"b": `{"result": {"items": [3, 4], "nextCursor": "c"}, "total": 5}`,
+			"c": `{"result": {"items": [5]}, "total": 5}`,
+		}, &requested)
+
+		statement := domain.Statement{
+			Method:       "from",
+			Resource:     "product",
+			DependsOn:    domain.DependsOn{Resolved: true},
+			Paginate:     &domain.Pagination{Param: "cursor", Path: []string{"result", "nextCursor"}},
+			IgnoreErrors: true,
+		}
+
+		executor := runner.NewExecutor(test.NoOpLogger, client, runner.ExecutorOptions{ResourceTimeout: time.Second})
+		got := executor.DoStatement(context.Background(), statement, queryCtx)
+
+		test.Equal(t, requested, []interface{}{nil, "b", "c"})
+		test.Equal(t, got.Status, 200)
+		test.Equal(t, got.Success, true)
+		test.Equal(t, got.IgnoreErrors, true)
+		test.Equal(t, got.ResponseTime, int64(30))
+		test.Equal(t, got.ResponseBody.Unmarshal(), test.Unmarshal(`{"result": {"items": [1, 2, 3, 4, 5]}, "total": 5}`))
+		test.Equal(t, len(got.Pages), 3)
+		test.Equal(t, got.Pages[1].URL, "http://product.io/api?cursor=b")
+	})
+
+	t.Run("should stop when the page limit is reached", func(t *testing.T) {
+		var requested []interface{}
+		client := pagedClient(map[interface{}]string{
+			nil: `{"items": [{"id": 1}], "next": "b"}`,
+			"b": `{"items": [{"id": 2}], "next": "c"}`,
+		}, &requested)
+
+		statement := domain.Statement{
+			Method:    "from",
+			Resource:  "product",
+			DependsOn: domain.DependsOn{Resolved: true},
+			Paginate:  &domain.Pagination{Param: "cursor", Path: []string{"next"}, MaxPages: 2},
+		}
+
+		executor := runner.NewExecutor(test.NoOpLogger, client, runner.ExecutorOptions{ResourceTimeout: time.Second})
+		got := executor.DoStatement(context.Background(), statement, queryCtx)
+
+		test.Equal(t, requested, []interface{}{nil, "b"})
+		test.Equal(t, got.ResponseBody.Unmarshal(), test.Unmarshal(`{"items": [{"id": 1}, {"id": 2}], "next": "c"}`))
+	})
+
+	t.Run("should return the successful pages when a later page fails", func(t *testing.T) {
+		var requested []interface{}
+		client := pagedClient(map[interface{}]string{
+			nil: `{"items": [1], "next": "b"}`,
+			"b": `{"items": [2], "next": "c"}`,
+		}, &requested)
+
+		statement := domain.Statement{
+			Method:    "from",
+			Resource:  "product",
+			DependsOn: domain.DependsOn{Resolved: true},
+			Paginate:  &domain.Pagination{Param: "cursor", Path: []string{"next"}},
+		}
+
+		executor := runner.NewExecutor(test.NoOpLogger, client, runner.ExecutorOptions{ResourceTimeout: time.Second})
+		got := executor.DoStatement(context.Background(), statement, queryCtx)
+
+		test.Equal(t, requested, []interface{}{nil, "b", "c"})
+		test.Equal(t, got.Status, 200)
+		test.Equal(t, got.Success, true)
+		test.Equal(t, got.ResponseBody.Unmarshal(), test.Unmarshal(`{"items": [1, 2], "next": "c"}`))
+		test.Equal(t, len(got.Pages), 3)
+		test.Equal(t, got.Pages[2].Status, 500)
+		test.Equal(t, got.Pages[2].Success, false)
+	})
+
+	t.Run("should return the failed page when the first request fails", func(t *testing.T) {
+		var requested []interface{}
+		client := pagedClient(map[interface{}]string{}, &requested)
+
+		statement := domain.Statement{
+			Method:    "from",
+			Resource:  "product",
+			DependsOn: domain.DependsOn{Resolved: true},
+			Paginate:  &domain.Pagination{Param: "cursor", Path: []string{"next"}},
+		}
+
+		executor := runner.NewExecutor(test.NoOpLogger, client, runner.ExecutorOptions{ResourceTimeout: time.Second})
+		got := executor.DoStatement(context.Background(), statement, queryCtx)
+
+		test.Equal(t, requested, []interface{}{nil})
+		test.Equal(t, got.Status, 500)
+		test.Equal(t, got.Success, false)
+		test.Equal(t, len(got.Pages), 0)
+	})
+}
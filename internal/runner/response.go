@@ -116,11 +116,42 @@ func isEmptyChained(value interface{}) bool {
 	}
 }
 
+// NewSkippedResponse builds a DoneResource for a statement
+// that was not executed, such as when its `when` or
+// `depends-on` condition is not met, keeping the reason
+// in its details.
+func NewSkippedResponse(log restql.Logger, reason string, options DoneResourceOptions) restql.DoneResource {
+	return restql.DoneResource{
+		Status:       204,
+		Success:      true,
+		Skipped:      true,
+		SkipReason:   reason,
+		IgnoreErrors: options.IgnoreErrors,
+		ResponseBody: restql.NewResponseBodyFromBytes(log, nil),
+	}
+}
+
+const unmetConditionReason = "when condition not met"
+
+func unmetDependsOnReason(stmt domain.Statement) string {
+	return "depends-on condition not met: " + strings.Join(stmt.DependsOn.Targets, ", ") + " on " + stmt.DependsOn.Condition
+}
+
+// NewFallbackResponse replaces the body of a failed statement
+// result by the `on-error` default value, keeping the details
+// of the upstream response.
+func NewFallbackResponse(log restql.Logger, dr restql.DoneResource, onError *domain.OnError) restql.DoneResource {
+	dr.Fallback = true
+	dr.ResponseBody = restql.NewResponseBodyFromValue(log, copyValue(onError.Default))
+
+	return dr
+}
+
 func NewNewDependsOnUnresolvedResponse(log restql.Logger, stmt domain.Statement, options DoneResourceOptions) restql.DoneResource {
 	var buf bytes.Buffer
 
 	buf.WriteString("The request was skipped due to unresolved dependency { ")
-	buf.WriteString(stmt.DependsOn.Target)
+	buf.WriteString(strings.Join(stmt.DependsOn.Targets, ", "))
 	buf.WriteString(" }")
 
 	rb := restql.NewResponseBodyFromValue(log, buf.String())
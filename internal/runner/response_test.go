@@ -355,7 +355,7 @@ func TestNewDependsOnUnresolvedResponse(t *testing.T) {
 	t.Run("should create response for unresolved statement", func(t *testing.T) {
 		statement := domain.Statement{
 			DependsOn: domain.DependsOn{
-				Target:   "failed-resource",
+				Targets:  []string{"failed-resource"},
 				Resolved: false,
 			},
 		}
@@ -379,7 +379,7 @@ func TestNewDependsOnUnresolvedResponse(t *testing.T) {
 	t.Run("should create response for empty chained statement with ignore errors", func(t *testing.T) {
 		statement := domain.Statement{
 			DependsOn: domain.DependsOn{
-				Target:   "failed-resource",
+				Targets:  []string{"failed-resource"},
 				Resolved: false,
 			},
 		}
@@ -400,3 +400,71 @@ func TestNewDependsOnUnresolvedResponse(t *testing.T) {
 		test.Equal(t, got, expected)
 	})
 }
+
+func TestNewSkippedResponse(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement domain.Statement
+		expected  restql.DoneResource
+	}{
+		{
+			"should create skipped response for unmet when condition",
+			domain.Statement{Method: "from", Resource: "loyalty", IgnoreErrors: true, When: &domain.Condition{Left: nil}},
+			restql.DoneResource{
+				Status:       204,
+				Success:      true,
+				Skipped:      true,
+				SkipReason:   "when condition not met",
+				IgnoreErrors: true,
+				ResponseBody: restql.NewResponseBodyFromBytes(test.NoOpLogger, nil),
+			},
+		},
+		{
+			"should create skipped response for unmet depends-on condition",
+			domain.Statement{Method: "to", Resource: "refund", IgnoreErrors: true, DependsOn: domain.DependsOn{Targets: []string{"payment", "reserve"}, Condition: domain.DependsOnFailure}},
+			restql.DoneResource{
+				Status:       204,
+				Success:      true,
+				Skipped:      true,
+				SkipReason:   "depends-on condition not met: payment, reserve on failure",
+				IgnoreErrors: true,
+				ResponseBody: restql.NewResponseBodyFromBytes(test.NoOpLogger, nil),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, skipped := runner.SkipUnmetConditions(test.NoOpLogger, domain.Resources{"resource": tt.statement})
+
+			test.Equal(t, skipped, domain.Resources{"resource": tt.expected})
+		})
+	}
+}
+
+func TestNewFallbackResponse(t *testing.T) {
+	t.Run("should replace failed response body by fallback value", func(t *testing.T) {
+		fallback := map[string]interface{}{"items": []interface{}{}}
+		failed := restql.DoneResource{
+			Status:       503,
+			Success:      false,
+			URL:          "http://hero.io/api",
+			ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, "service unavailable"),
+		}
+
+		expected := restql.DoneResource{
+			Status:       503,
+			Success:      false,
+			Fallback:     true,
+			URL:          "http://hero.io/api",
+			ResponseBody: restql.NewResponseBodyFromValue(test.NoOpLogger, map[string]interface{}{"items": []interface{}{}}),
+		}
+
+		got := runner.NewFallbackResponse(test.NoOpLogger, failed, &domain.OnError{Default: fallback})
+
+		test.Equal(t, got, expected)
+
+		got.ResponseBody.Unmarshal().(map[string]interface{})["items"] = "changed"
+		test.Equal(t, fallback, map[string]interface{}{"items": []interface{}{}})
+	})
+}
@@ -0,0 +1,96 @@
+package runner
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+)
+
+// retryRequest repeats a failed request according to the
+// statement `retry` clause, waiting the backoff delay between
+// attempts. All attempts, including backoff delays, must fit
+// into the request timeout and stop if the query is cancelled.
+// Only GET requests are retried, since the upstream may have
+// applied a write before failing, unless the clause is unsafe.
+func (e Executor) retryRequest(ctx context.Context, retry *domain.Retry, request restql.HTTPRequest, response restql.HTTPResponse, err error) (restql.HTTPRequest, restql.HTTPResponse, error) {
+	log := restql.GetLogger(ctx)
+
+	if request.Method != http.MethodGet && !retry.Unsafe {
+		return request, response, err
+	}
+
+	attempts, _ := retry.Attempts.(int)
+	backoff := parseBackoff(retry.Backoff)
+	deadline := time.Now().Add(request.Timeout - response.Duration)
+
+	for request.RetryAttempt < attempts && e.shouldRetry(response, err) {
+		if !waitBackoff(ctx, backoff, deadline) {
+			break
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			break
+		}
+
+		request.RetryAttempt++
+		request.Timeout = remaining
+
+		log.Debug("retrying request", "attempt", request.RetryAttempt, "url", response.URL, "status", response.StatusCode, "error", err)
+
+		response, err = e.client.Do(ctx, request)
+		backoff *= 2
+	}
+
+	return request, response, err
+}
+
+func (e Executor) shouldRetry(response restql.HTTPResponse, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	for _, code := range e.retryStatusCodes {
+		if response.StatusCode == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseBackoff(backoff interface{}) time.Duration {
+	ms, ok := backoff.(int)
+	if !ok || ms < 0 {
+		return 0
+	}
+
+	return time.Duration(ms) * time.Millisecond
+}
+
+func waitBackoff(ctx context.Context, backoff time.Duration, deadline time.Time) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if backoff <= 0 {
+		return true
+	}
+
+	if time.Now().Add(backoff).After(deadline) {
+		return false
+	}
+
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
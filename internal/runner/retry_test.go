@@ -0,0 +1,175 @@
+package runner_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
+	"github.com/b2wdigital/restQL-golang/v6/internal/runner"
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+	"github.com/b2wdigital/restQL-golang/v6/test"
+)
+
+func TestRetryStatement(t *testing.T) {
+	queryCtx := restql.QueryContext{Mappings: map[string]restql.Mapping{"hero": mapping(t, "http://hero.io/api")}}
+	options := runner.ExecutorOptions{ResourceTimeout: time.Second, RetryStatusCodes: []int{503}}
+
+	sequenceClient := func(responses []restql.HTTPResponse, errs []error, attempts *[]int) stubHTTPClient {
+		return func(request restql.HTTPRequest) (restql.HTTPResponse, error) {
+			i := len(*attempts)
+			*attempts = append(*attempts, request.RetryAttempt)
+
+			return responses[i], errs[i]
+		}
+	}
+
+	okResponse := restql.HTTPResponse{StatusCode: 200, Body: restql.NewResponseBodyFromValue(test.NoOpLogger, test.Unmarshal(`{"id": 1}`))}
+	unavailableResponse := restql.HTTPResponse{StatusCode: 503, Body: restql.NewResponseBodyFromValue(test.NoOpLogger, nil)}
+
+	t.Run("should retry on configured status code and network error", func(t *testing.T) {
+		var attempts []int
+		client := sequenceClient(
+			[]restql.HTTPResponse{unavailableResponse, {}, okResponse},
+			[]error{nil, errors.New("connection reset"), nil},
+			&attempts,
+		)
+
+		statement := domain.Statement{
+			Method:    "from",
+			Resource:  "hero",
+			DependsOn: domain.DependsOn{Resolved: true},
+			Retry:     &domain.Retry{Attempts: 2, Backoff: 1},
+		}
+
+		got := runner.NewExecutor(test.NoOpLogger, client, options).DoStatement(context.Background(), statement, queryCtx)
+
+		test.Equal(t, attempts, []int{0, 1, 2})
+		test.Equal(t, got.Status, 200)
+		test.Equal(t, got.Retries, 2)
+	})
+
+	t.Run("should stop retrying when attempts are exhausted", func(t *testing.T) {
+		var attempts []int
+		client := sequenceClient(
+			[]restql.HTTPResponse{unavailableResponse, unavailableResponse, okResponse},
+			[]error{nil, nil, nil},
+			&attempts,
+		)
+
+		statement := domain.Statement{
+			Method:    "from",
+			Resource:  "hero",
+			DependsOn: domain.DependsOn{Resolved: true},
+			Retry:     &domain.Retry{Attempts: 1},
+		}
+
+		got := runner.NewExecutor(test.NoOpLogger, client, options).DoStatement(context.Background(), statement, queryCtx)
+
+		test.Equal(t, attempts, []int{0, 1})
+		test.Equal(t, got.Status, 503)
+		test.Equal(t, got.Retries, 1)
+	})
+
+	t.Run("should not retry on status code not configured", func(t *testing.T) {
+		var attempts []int
+		client := sequenceClient(
+			[]restql.HTTPResponse{{StatusCode: 404, Body: restql.NewResponseBodyFromValue(test.NoOpLogger, nil)}, okResponse},
+			[]error{nil, nil},
+			&attempts,
+		)
+
+		statement := domain.Statement{
+			Method:    "from",
+			Resource:  "hero",
+			DependsOn: domain.DependsOn{Resolved: true},
+			Retry:     &domain.Retry{Attempts: 3},
+		}
+
+		got := runner.NewExecutor(test.NoOpLogger, client, options).DoStatement(context.Background(), statement, queryCtx)
+
+		test.Equal(t, attempts, []int{0})
+		test.Equal(t, got.Status, 404)
+		test.Equal(t, got.Retries, 0)
+	})
+
+	t.Run("should retry write statements only when unsafe", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			retry    *domain.Retry
+			expected []int
+		}{
+			{"safe", &domain.Retry{Attempts: 2}, []int{0}},
+			{"unsafe", &domain.Retry{Attempts: 2, Unsafe: true}, []int{0, 1, 2}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var attempts []int
+				client := sequenceClient(
+					[]restql.HTTPResponse{unavailableResponse, unavailableResponse, okResponse},
+					[]error{nil, nil, nil},
+					&attempts,
+				)
+
+				statement := domain.Statement{
+					Method:    "to",
+					Resource:  "hero",
+					DependsOn: domain.DependsOn{Resolved: true},
+					Retry:     tt.retry,
+				}
+
+				runner.NewExecutor(test.NoOpLogger, client, options).DoStatement(context.Background(), statement, queryCtx)
+
+				test.Equal(t, attempts, tt.expected)
+			})
+		}
+	})
+
+	t.Run("should not retry when backoff exceeds the statement timeout", func(t *testing.T) {
+		var attempts []int
+		client := sequenceClient(
+			[]restql.HTTPResponse{unavailableResponse, okResponse},
+			[]error{nil, nil},
+			&attempts,
+		)
+
+		statement := domain.Statement{
+			Method:    "from",
+			Resource:  "hero",
+			DependsOn: domain.DependsOn{Resolved: true},
+			Timeout:   10,
+			Retry:     &domain.Retry{Attempts: 3, Backoff: 500},
+		}
+
+		got := runner.NewExecutor(test.NoOpLogger, client, options).DoStatement(context.Background(), statement, queryCtx)
+
+		test.Equal(t, attempts, []int{0})
+		test.Equal(t, got.Status, 503)
+	})
+
+	t.Run("should not retry when query context is done", func(t *testing.T) {
+		var attempts []int
+		client := sequenceClient(
+			[]restql.HTTPResponse{unavailableResponse, okResponse},
+			[]error{nil, nil},
+			&attempts,
+		)
+
+		statement := domain.Statement{
+			Method:    "from",
+			Resource:  "hero",
+			DependsOn: domain.DependsOn{Resolved: true},
+			Retry:     &domain.Retry{Attempts: 3},
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		got := runner.NewExecutor(test.NoOpLogger, client, options).DoStatement(ctx, statement, queryCtx)
+
+		test.Equal(t, attempts, []int{0})
+		test.Equal(t, got.Status, 503)
+	})
+}
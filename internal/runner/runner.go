@@ -22,6 +22,10 @@ var (
 	// ErrMaxGoroutineDenied represents the event when restQL reaches the maximum
 	// number of concurrent goroutines and cannot process anymore.
 	ErrMaxGoroutineDenied = errors.New("max concurrent goroutine reached: statement execution denied")
+
+	// ErrMaxFanOutExceeded represents the event when a statement is
+	// multiplexed into more requests than the maximum allowed.
+	ErrMaxFanOutExceeded = errors.New("max fan-out exceeded: statement execution denied")
 )
 
 // Options wraps all configuration parameters for the Runner
@@ -29,6 +33,7 @@ type Options struct {
 	GlobalQueryTimeout      time.Duration
 	MaxConcurrentQueries    int
 	MaxConcurrentGoroutines int
+	MaxFanOut               int
 }
 
 // Runner process a query into a Resource collection
@@ -64,6 +69,8 @@ func (r Runner) ExecuteQuery(ctx context.Context, query domain.Query, queryCtx r
 	}
 	defer r.queryLimiter.Release()
 
+	ctx = domain.WithTenant(ctx, queryCtx.Options.Tenant)
+
 	var cancel context.CancelFunc
 	queryTimeout, ok := r.parseQueryTimeout(query)
 	if ok {
@@ -78,6 +85,11 @@ func (r Runner) ExecuteQuery(ctx context.Context, query domain.Query, queryCtx r
 		return nil, err
 	}
 
+	err = ValidateFanOut(resources, r.options.MaxFanOut)
+	if err != nil {
+		return nil, err
+	}
+
 	state := NewState(resources)
 
 	requestCh := make(chan request, 10)
@@ -94,6 +106,7 @@ func (r Runner) ExecuteQuery(ctx context.Context, query domain.Query, queryCtx r
 		state:            state,
 		ctx:              ctx,
 		goroutineLimiter: r.goroutineLimiter,
+		maxFanOut:        r.options.MaxFanOut,
 	}
 
 	requestWorker := &requestWorker{
@@ -150,6 +163,12 @@ func (r Runner) initializeResources(query domain.Query) (domain.Resources, error
 
 	resources = ApplyModifiers(resources, query.Use)
 	resources = ApplyEncoders(resources, r.log)
+
+	err = ValidateExpectedFanOut(resources, r.options.MaxFanOut)
+	if err != nil {
+		return nil, err
+	}
+
 	resources = MultiplexStatements(resources)
 
 	return resources, nil
@@ -174,6 +193,7 @@ type stateWorker struct {
 	state            *State
 	ctx              context.Context
 	goroutineLimiter *limiter
+	maxFanOut        int
 }
 
 func (sw *stateWorker) Run() {
@@ -185,10 +205,27 @@ func (sw *stateWorker) Run() {
 
 		availableResources = ResolveChainedValues(availableResources, sw.state.Done())
 		availableResources = ResolveDependsOn(availableResources, sw.state.Done())
+
+		availableResources, skippedResources := SkipUnmetConditions(sw.log, availableResources)
+		for resourceID, response := range skippedResources {
+			sw.state.UpdateDone(resourceID, response)
+		}
+
 		availableResources = ApplyEncoders(availableResources, sw.log)
+
+		if err := ValidateExpectedFanOut(availableResources, sw.maxFanOut); err != nil {
+			sw.fail(err)
+			return
+		}
+
 		availableResources = MultiplexStatements(availableResources)
 		availableResources = UnwrapNoMultiplex(availableResources)
 
+		if err := ValidateFanOut(availableResources, sw.maxFanOut); err != nil {
+			sw.fail(err)
+			return
+		}
+
 		for resourceID, stmt := range availableResources {
 			resourceID, stmt := resourceID, stmt
 			success := sw.goroutineLimiter.Acquire()
@@ -211,6 +248,10 @@ func (sw *stateWorker) Run() {
 			}()
 		}
 
+		if len(skippedResources) > 0 && len(sw.state.Requested()) == 0 {
+			continue
+		}
+
 		select {
 		case result := <-sw.resultCh:
 			sw.state.UpdateDone(result.ResourceIdentifier, result.Response)
@@ -225,6 +266,13 @@ func (sw *stateWorker) Run() {
 	}
 }
 
+func (sw *stateWorker) fail(err error) {
+	select {
+	case sw.errorCh <- err:
+	case <-sw.ctx.Done():
+	}
+}
+
 type requestWorker struct {
 	requestCh        chan request
 	resultCh         chan result
@@ -328,6 +376,10 @@ func (rw *requestWorker) runMultiplexedStatement(statements []interface{}, resou
 		responses[i] = <-ch
 	}
 
+	if isBatched(statements) {
+		return result{ResourceIdentifier: resourceID, Response: mergeBatchResponses(restql.GetLogger(rw.ctx), responses)}
+	}
+
 	return result{ResourceIdentifier: resourceID, Response: responses}
 }
 
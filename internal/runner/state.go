@@ -1,6 +1,8 @@
 package runner
 
 import (
+	"sort"
+
 	"github.com/b2wdigital/restQL-golang/v6/internal/domain"
 )
 
@@ -52,57 +54,73 @@ func (s *State) canRequestMultiplexedGroup(statements []interface{}) bool {
 }
 
 func (s *State) canRequest(statement domain.Statement) bool {
-	dependsOnTarget := statement.DependsOn.Target
-	if dependsOnTarget != "" {
-		_, found := s.done[domain.ResourceID(dependsOnTarget)]
+	for _, target := range StatementDependencies(statement) {
+		_, found := s.done[domain.ResourceID(target)]
 		if !found {
 			return false
 		}
 	}
 
+	return true
+}
+
+// StatementDependencies returns, sorted, the identifiers of the
+// statements that must be done before the given one can be
+// requested: its `depends-on` targets and the statements
+// referenced by its chained values, including those of the
+// `on-error` fallback, built when its response is received.
+func StatementDependencies(statement domain.Statement) []string {
+	targets := make(map[string]bool)
+	for _, target := range statement.DependsOn.Targets {
+		targets[target] = true
+	}
+
 	for _, v := range statement.With.Values {
-		if !s.isValueResolved(v) {
-			return false
-		}
+		collectChainTargets(v, targets)
 	}
 
 	for _, v := range statement.Headers {
-		if !s.isValueResolved(v) {
-			return false
-		}
+		collectChainTargets(v, targets)
 	}
 
-	return true
+	if statement.When != nil {
+		collectChainTargets(statement.When.Left, targets)
+		collectChainTargets(statement.When.Right, targets)
+	}
+
+	if statement.OnError != nil {
+		collectChainTargets(statement.OnError.Default, targets)
+	}
+
+	result := make([]string, 0, len(targets))
+	for t := range targets {
+		result = append(result, t)
+	}
+	sort.Strings(result)
+
+	return result
 }
 
-func (s *State) isValueResolved(value interface{}) bool {
+func collectChainTargets(value interface{}, targets map[string]bool) {
 	switch value := value.(type) {
 	case domain.Chain:
-		resourceTarget, ok := value[0].(string)
-		if !ok {
-			return false
+		if target, ok := value[0].(string); ok {
+			targets[target] = true
 		}
-
-		_, found := s.done[domain.ResourceID(resourceTarget)]
-		return found
 	case domain.Function:
-		return s.isValueResolved(value.Target())
+		collectChainTargets(value.Target(), targets)
+	case domain.Template:
+		for _, part := range value {
+			collectChainTargets(part, targets)
+		}
 	case map[string]interface{}:
 		for _, v := range value {
-			if !s.isValueResolved(v) {
-				return false
-			}
+			collectChainTargets(v, targets)
 		}
-		return true
 	case []interface{}:
 		for _, v := range value {
-			if !s.isValueResolved(v) {
-				return false
-			}
+			collectChainTargets(v, targets)
 		}
-		return true
-	default:
-		return true
 	}
 }
 
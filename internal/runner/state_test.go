@@ -46,7 +46,8 @@ func TestAvailableResources(t *testing.T) {
 		villainStatement := domain.Statement{Method: "from", Resource: "villain", With: domain.Params{Values: map[string]interface{}{"id": []interface{}{domain.Chain{"hero", "villain", "id"}}}}}
 		crossoverStatement := domain.Statement{Method: "from", Resource: "crossover", With: domain.Params{Values: map[string]interface{}{"id": map[string]interface{}{"heroes": domain.Chain{"hero", "id"}}}}}
 		combosStatement := domain.Statement{Method: "from", Resource: "combos", Headers: map[string]interface{}{"id": domain.Chain{"hero", "combo", "id"}}}
-		civilStatement := domain.Statement{Method: "from", Resource: "civil", DependsOn: domain.DependsOn{Target: "crossover"}}
+		civilStatement := domain.Statement{Method: "from", Resource: "civil", DependsOn: domain.DependsOn{Targets: []string{"crossover"}}}
+		shieldStatement := domain.Statement{Method: "from", Resource: "shield", OnError: &domain.OnError{Default: map[string]interface{}{"leader": domain.Chain{"hero", "name"}}}}
 
 		input := domain.Resources{
 			"hero":      heroStatement,
@@ -55,6 +56,7 @@ func TestAvailableResources(t *testing.T) {
 			"crossover": crossoverStatement,
 			"combo":     combosStatement,
 			"civil":     civilStatement,
+			"shield":    shieldStatement,
 		}
 
 		expected := domain.Resources{
@@ -94,6 +96,27 @@ func TestAvailableResources(t *testing.T) {
 
 		test.Equal(t, got, expected)
 	})
+
+	t.Run("should wait for dependency chained inside template", func(t *testing.T) {
+		heroStatement := domain.Statement{Method: "from", Resource: "hero", With: domain.Params{Values: map[string]interface{}{"id": "123456"}}}
+		sidekickStatement := domain.Statement{Method: "from", Resource: "sidekick", With: domain.Params{Values: map[string]interface{}{"path": domain.Template{"/v2/", domain.Chain{"hero", "id"}}}}}
+		villainStatement := domain.Statement{Method: "from", Resource: "villain", Headers: map[string]interface{}{"Authorization": domain.Template{"Bearer ", domain.Chain{"hero", "token"}}}}
+
+		input := domain.Resources{
+			"hero":     heroStatement,
+			"sidekick": sidekickStatement,
+			"villain":  villainStatement,
+		}
+
+		state := runner.NewState(input)
+
+		test.Equal(t, state.Available(), domain.Resources{"hero": heroStatement})
+
+		state.SetAsRequest("hero")
+		state.UpdateDone("hero", nil)
+
+		test.Equal(t, state.Available(), domain.Resources{"sidekick": sidekickStatement, "villain": villainStatement})
+	})
 }
 
 func TestSetAsRequested(t *testing.T) {
@@ -7,6 +7,7 @@ import (
 // UnwrapNoMultiplex transform a collection of unresolved Resources
 // with `no-multiplex` functions into a collection of Resources
 // without it.
+// The `batch` and `cross` functions left, whose target is not a list, are also removed.
 func UnwrapNoMultiplex(resources domain.Resources) domain.Resources {
 	for resourceID, resource := range resources {
 		resources[resourceID] = unwrapResource(resource)
@@ -48,8 +49,8 @@ func unwrapStatement(statement domain.Statement) domain.Statement {
 
 func unwrapBody(body interface{}) interface{} {
 	switch body := body.(type) {
-	case domain.NoMultiplex:
-		return body.Target()
+	case domain.NoMultiplex, domain.Batch, domain.Cross:
+		return body.(domain.Function).Target()
 	default:
 		return body
 	}
@@ -59,6 +60,8 @@ func unwrapValue(value interface{}) interface{} {
 	switch value := value.(type) {
 	case domain.NoMultiplex:
 		return value.Target()
+	case domain.Batch, domain.Cross:
+		return unwrapValue(value.(domain.Function).Target())
 	case map[string]interface{}:
 		m := make(map[string]interface{})
 		for k, v := range value {
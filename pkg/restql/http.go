@@ -12,15 +12,19 @@ type Headers map[string]string
 
 // HttpRequest represents a HTTP call to be
 // made to an upstream dependency defined by the mappings.
+//
+// RetryAttempt is the number of the retry defined by the
+// statement `retry` clause, being 0 for the first request.
 type HTTPRequest struct {
-	Method  string
-	Schema  string
-	Host    string
-	Path    string
-	Query   map[string]interface{}
-	Body    Body
-	Headers Headers
-	Timeout time.Duration
+	Method       string
+	Schema       string
+	Host         string
+	Path         string
+	Query        map[string]interface{}
+	Body         Body
+	Headers      Headers
+	Timeout      time.Duration
+	RetryAttempt int
 }
 
 // HttpResponse represents a HTTP call result
@@ -19,6 +19,9 @@ var urlRegex = regexp.MustCompile(`(https?)://([^/]+)([^?]*)\??(.*)`)
 //• QueryRevisions parameters: can be defined by placing a colon (:) before an identifier in the URL query,
 // for example "http://some.api?:page", will replace ":page" by the value of the "page" parameter
 // in the query definition creating the URL "http://some.api?page=<value>".
+//• Options: can be defined as a comma separated list in the URL fragment,
+// for example "http://some.api/config#no-coalescing", and are never sent
+// to the resource.
 type Mapping struct {
 	resourceName  string
 	url           string
@@ -28,17 +31,37 @@ type Mapping struct {
 	query         map[string]interface{}
 	pathParams    []string
 	pathParamsSet map[string]struct{}
+	options       map[string]struct{}
 
 	Source Source
 }
 
+// MappingOptionNoCoalescing prevents the requests to the resource
+// from sharing an upstream call with identical in-flight requests.
+const MappingOptionNoCoalescing = "no-coalescing"
+
+var mappingOptions = map[string]struct{}{
+	MappingOptionNoCoalescing: {},
+}
+
 // NewMapping constructs a Mapping value from a resource name
 // and a canonical URL with optional identifiers for
 // path and query parameters.
 func NewMapping(resource, url string) (Mapping, error) {
 	mapping := Mapping{resourceName: resource, url: url}
 
-	urlMatches := urlRegex.FindAllStringSubmatch(url, -1)
+	location, fragment := url, ""
+	if i := strings.Index(url, "#"); i >= 0 {
+		location, fragment = url[:i], url[i+1:]
+	}
+
+	options, err := parseMappingOptions(fragment)
+	if err != nil {
+		return Mapping{}, errors.Wrapf(err, "failed to create mapping from %s", url)
+	}
+	mapping.options = options
+
+	urlMatches := urlRegex.FindAllStringSubmatch(location, -1)
 	if len(urlMatches) == 0 {
 		return Mapping{}, errors.Errorf("failed to create mapping from %s", url)
 	}
@@ -74,6 +97,24 @@ func NewMapping(resource, url string) (Mapping, error) {
 	return mapping, nil
 }
 
+func parseMappingOptions(fragment string) (map[string]struct{}, error) {
+	if fragment == "" {
+		return nil, nil
+	}
+
+	options := make(map[string]struct{})
+	for _, o := range strings.Split(fragment, ",") {
+		name := strings.TrimSpace(o)
+		if _, found := mappingOptions[name]; !found {
+			return nil, errors.Errorf("unknown mapping option %s", name)
+		}
+
+		options[name] = struct{}{}
+	}
+
+	return options, nil
+}
+
 func parseQueryParametersInURL(queryParams string) map[string]interface{} {
 	if queryParams == "" {
 		return nil
@@ -124,6 +165,12 @@ func (m Mapping) IsQueryParam(name string) bool {
 	return found
 }
 
+// HasOption returns true if the given option is set on the mapping URL
+func (m Mapping) HasOption(name string) bool {
+	_, found := m.options[name]
+	return found
+}
+
 // PathWithParams takes a map of key/value pairs and use it as value source
 // to replace path parameters defined by identifiers.
 
@@ -94,3 +94,28 @@ func TestMappingsQueryWithParams(t *testing.T) {
 		})
 	}
 }
+
+func TestMappingsOptions(t *testing.T) {
+	t.Run("should parse options from the URL fragment without sending them", func(t *testing.T) {
+		mapping, err := restql.NewMapping("test-resource", "http://hero.api/hero/:id?:name#no-coalescing")
+		test.VerifyError(t, err)
+
+		test.Equal(t, mapping.HasOption(restql.MappingOptionNoCoalescing), true)
+		test.Equal(t, mapping.PathWithParams(map[string]interface{}{"id": "1"}), "/hero/1")
+		test.Equal(t, mapping.QueryWithParams(map[string]interface{}{"name": "batman"}), map[string]interface{}{"name": "batman"})
+		test.Equal(t, mapping.URL(), "http://hero.api/hero/:id?:name#no-coalescing")
+	})
+
+	t.Run("should not set options absent from the URL", func(t *testing.T) {
+		mapping, err := restql.NewMapping("test-resource", "http://hero.api/hero")
+		test.VerifyError(t, err)
+
+		test.Equal(t, mapping.HasOption(restql.MappingOptionNoCoalescing), false)
+	})
+
+	t.Run("should fail on unknown option", func(t *testing.T) {
+		_, err := restql.NewMapping("test-resource", "http://hero.api/hero#no-caching")
+
+		test.Equal(t, err.Error(), "failed to create mapping from http://hero.api/hero#no-caching: unknown mapping option no-caching")
+	})
+}
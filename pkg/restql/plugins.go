@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/http"
 	"net/url"
+	"strconv"
 	"sync"
 )
 
@@ -23,16 +24,18 @@ var (
 type pluginIndex struct {
 	lifecycle []PluginInfo
 	dbPlugin  *PluginInfo
+	functions []PluginInfo
 }
 
 // Plugin types
 const (
 	LifecyclePluginType PluginType = iota
 	DatabasePluginType
+	FunctionPluginType
 )
 
 // PluginType is an enum of possible plugin types supported by restQL,
-// currently supports LifecyclePluginType and DatabasePluginType.
+// currently supports LifecyclePluginType, DatabasePluginType and FunctionPluginType.
 type PluginType int
 
 func (pt PluginType) String() string {
@@ -41,6 +44,8 @@ func (pt PluginType) String() string {
 		return "Lifecycle"
 	case DatabasePluginType:
 		return "Database"
+	case FunctionPluginType:
+		return "Function"
 	default:
 		return "Unknown"
 	}
@@ -56,8 +61,8 @@ type PluginInfo struct {
 
 // RegisterPlugin indexes the provided plugin information
 // for latter usage by restQL in runtime.
-// It supports registration of multiple Lifecycle plugins
-// but only one Database plugin.
+// It supports registration of multiple Lifecycle and Function
+// plugins but only one Database plugin.
 // In case of failure to register the plugin a warn
 // message will be printed to the os.Stdout.
 func RegisterPlugin(pluginInfo PluginInfo) {
@@ -74,6 +79,8 @@ func RegisterPlugin(pluginInfo PluginInfo) {
 		}
 
 		plugins.dbPlugin = &pluginInfo
+	case FunctionPluginType:
+		plugins.functions = append(plugins.functions, pluginInfo)
 	default:
 		log.Printf("[WARN] unknown plugin type: %s", pluginInfo.Type)
 	}
@@ -88,6 +95,15 @@ func GetLifecyclePlugins() []PluginInfo {
 	return lp
 }
 
+func GetFunctionPlugins() []PluginInfo {
+	pluginsMu.RLock()
+	defer pluginsMu.RUnlock()
+
+	fp := plugins.functions
+
+	return fp
+}
+
 func GetDatabasePlugin() (PluginInfo, bool) {
 	pluginsMu.RLock()
 	defer pluginsMu.RUnlock()
@@ -112,6 +128,92 @@ type LifecyclePlugin interface {
 	AfterRequest(ctx context.Context, request HTTPRequest, response HTTPResponse, err error) context.Context
 }
 
+// FunctionPlugin is the interface that defines a function
+// applied by the `->` operator to `with` parameter values and
+// to fields selected by the `only` clause. The plugin name is
+// used as the function name in the query.
+//
+// Apply receives the value with the function arguments already
+// resolved and returns the transformed value. If an error is
+// returned the parameter is not sent or the field is not returned.
+type FunctionPlugin interface {
+	Plugin
+	Apply(log Logger, value interface{}, args FunctionArguments) (interface{}, error)
+}
+
+// FunctionArguments represents the values given
+// to a function plugin in the query, in order.
+type FunctionArguments []interface{}
+
+// String returns the argument at the given position
+// if it exists and is a string.
+func (fa FunctionArguments) String(i int) (string, bool) {
+	if i < 0 || i >= len(fa) {
+		return "", false
+	}
+
+	s, ok := fa[i].(string)
+	return s, ok
+}
+
+// Int returns the argument at the given position if it
+// exists and is an integer or a string representing one.
+func (fa FunctionArguments) Int(i int) (int, bool) {
+	if i < 0 || i >= len(fa) {
+		return 0, false
+	}
+
+	switch v := fa[i].(type) {
+	case int:
+		return v, true
+	case float64:
+		return int(v), v == float64(int(v))
+	case string:
+		n, err := strconv.Atoi(v)
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// Float returns the argument at the given position if it
+// exists and is a number or a string representing one.
+func (fa FunctionArguments) Float(i int) (float64, bool) {
+	if i < 0 || i >= len(fa) {
+		return 0, false
+	}
+
+	switch v := fa[i].(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	case string:
+		n, err := strconv.ParseFloat(v, 64)
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// Bool returns the argument at the given position if it
+// exists and is a boolean or a string representing one.
+func (fa FunctionArguments) Bool(i int) (bool, bool) {
+	if i < 0 || i >= len(fa) {
+		return false, false
+	}
+
+	switch v := fa[i].(type) {
+	case bool:
+		return v, true
+	case string:
+		b, err := strconv.ParseBool(v)
+		return b, err == nil
+	default:
+		return false, false
+	}
+}
+
 // TransactionRequest represents a query execution
 // transaction received through the /run-query/* endpoints.
 type TransactionRequest struct {
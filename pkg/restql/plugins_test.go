@@ -0,0 +1,67 @@
+package restql_test
+
+import (
+	"testing"
+
+	"github.com/b2wdigital/restQL-golang/v6/pkg/restql"
+	"github.com/b2wdigital/restQL-golang/v6/test"
+)
+
+func TestFunctionArguments(t *testing.T) {
+	args := restql.FunctionArguments{"pt-BR", 2, float64(3), "4.5", true, "false", []interface{}{"x"}}
+
+	t.Run("should return string arguments", func(t *testing.T) {
+		s, ok := args.String(0)
+		test.Equal(t, s, "pt-BR")
+		test.Equal(t, ok, true)
+
+		_, ok = args.String(1)
+		test.Equal(t, ok, false)
+	})
+
+	t.Run("should return int arguments", func(t *testing.T) {
+		tests := []struct {
+			index    int
+			expected int
+			ok       bool
+		}{
+			{1, 2, true},
+			{2, 3, true},
+			{3, 0, false},
+			{6, 0, false},
+			{10, 0, false},
+		}
+
+		for _, tt := range tests {
+			i, ok := args.Int(tt.index)
+			test.Equal(t, i, tt.expected)
+			test.Equal(t, ok, tt.ok)
+		}
+	})
+
+	t.Run("should return float arguments", func(t *testing.T) {
+		f, ok := args.Float(3)
+		test.Equal(t, f, 4.5)
+		test.Equal(t, ok, true)
+
+		f, ok = args.Float(1)
+		test.Equal(t, f, float64(2))
+		test.Equal(t, ok, true)
+
+		_, ok = args.Float(0)
+		test.Equal(t, ok, false)
+	})
+
+	t.Run("should return bool arguments", func(t *testing.T) {
+		b, ok := args.Bool(4)
+		test.Equal(t, b, true)
+		test.Equal(t, ok, true)
+
+		b, ok = args.Bool(5)
+		test.Equal(t, b, false)
+		test.Equal(t, ok, true)
+
+		_, ok = args.Bool(-1)
+		test.Equal(t, ok, false)
+	})
+}
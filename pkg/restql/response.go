@@ -153,10 +153,18 @@ type ResourceCacheControl struct {
 }
 
 // DoneResource represents a statement result.
+// SkipReason describes why a skipped statement was not executed.
+// Fallback indicates that the upstream response body was
+// replaced by the statement `on-error` default value.
+// Coalesced indicates that the upstream response was shared
+// with identical requests made at the same time.
 type DoneResource struct {
 	Status          int
 	Success         bool
+	Skipped         bool
+	SkipReason      string
 	IgnoreErrors    bool
+	Fallback        bool
 	CacheControl    ResourceCacheControl
 	Method          string
 	URL             string
@@ -166,6 +174,9 @@ type DoneResource struct {
 	ResponseHeaders map[string]string
 	ResponseBody    *ResponseBody
 	ResponseTime    int64
+	Retries         int
+	Coalesced       bool
+	Pages           []DoneResource
 }
 
 // DoneResources represents a multiplexed statement result.